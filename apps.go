@@ -0,0 +1,352 @@
+package mimemagic
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Application is an application installed on the system, as
+// described by its desktop entry.
+type Application struct {
+	// ID is the desktop file ID, the path of the desktop entry
+	// relative to the applications directory it's installed in,
+	// with slashes replaced by dashes, such as
+	// kde4-okular.desktop.
+	ID, Path, Name, Exec, Icon string
+	// MediaTypes are the MIME types the application lists as
+	// the ones it can open.
+	MediaTypes []string
+}
+
+// XDGDirs are the directories Associations are loaded from, as
+// specified by the XDG Base Directory specification. Desktops are
+// the names of the desktop environments in use, in lower case,
+// whose specific mimeapps.list files take precedence over the
+// generic ones.
+type XDGDirs struct {
+	ConfigHome, DataHome string
+	ConfigDirs, DataDirs []string
+	Desktops             []string
+}
+
+// XDGDirsFromEnv returns the XDGDirs specified by the environment,
+// such as XDG_CONFIG_HOME and XDG_CURRENT_DESKTOP, or their
+// defaults if unset.
+func XDGDirsFromEnv() XDGDirs {
+	home, _ := os.UserHomeDir()
+	dirs := XDGDirs{
+		ConfigHome: xdgDir("XDG_CONFIG_HOME", filepath.Join(home, ".config")),
+		DataHome:   xdgDir("XDG_DATA_HOME", filepath.Join(home, ".local", "share")),
+		ConfigDirs: xdgDirList("XDG_CONFIG_DIRS", "/etc/xdg"),
+		DataDirs:   xdgDirList("XDG_DATA_DIRS", "/usr/local/share:/usr/share"),
+	}
+	for _, desktop := range filepath.SplitList(os.Getenv("XDG_CURRENT_DESKTOP")) {
+		dirs.Desktops = append(dirs.Desktops, strings.ToLower(desktop))
+	}
+	return dirs
+}
+
+func xdgDir(env, fallback string) string {
+	if dir := os.Getenv(env); filepath.IsAbs(dir) {
+		return dir
+	}
+	return fallback
+}
+
+func xdgDirList(env, fallback string) []string {
+	var dirs []string
+	for _, dir := range filepath.SplitList(os.Getenv(env)) {
+		if filepath.IsAbs(dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	if len(dirs) == 0 {
+		return filepath.SplitList(fallback)
+	}
+	return dirs
+}
+
+// ApplicationDirs returns the directories desktop entries are
+// installed in, in order of precedence.
+func (d XDGDirs) ApplicationDirs() []string {
+	dirs := []string{filepath.Join(d.DataHome, "applications")}
+	for _, dir := range d.DataDirs {
+		dirs = append(dirs, filepath.Join(dir, "applications"))
+	}
+	return dirs
+}
+
+// MimeAppsLists returns the paths of the mimeapps.list files, in
+// order of precedence.
+func (d XDGDirs) MimeAppsLists() []string {
+	dirs := append([]string{d.ConfigHome}, d.ConfigDirs...)
+	dirs = append(dirs, d.ApplicationDirs()...)
+	var paths []string
+	for _, dir := range dirs {
+		for _, desktop := range d.Desktops {
+			paths = append(paths, filepath.Join(dir, desktop+"-mimeapps.list"))
+		}
+		paths = append(paths, filepath.Join(dir, "mimeapps.list"))
+	}
+	return paths
+}
+
+// Associations are the associations between MIME types and the
+// applications that open them, per the XDG MIME Applications
+// Associations specification. MIME types are matched along with
+// their aliases, and MIME types without associations of their own
+// inherit the ones of the types they're a subclass of.
+type Associations struct {
+	apps  map[string]*Application
+	ids   []string
+	lists []mimeAppsList
+}
+
+// mimeAppsList holds the groups of a mimeapps.list file, keyed by
+// the canonical name of the MIME type.
+type mimeAppsList struct {
+	defaults, added, removed map[string][]string
+}
+
+// LoadAssociations reads the desktop entries and the mimeapps.list
+// files within dirs. Missing and unreadable files are skipped, as
+// they are by desktop environments.
+func LoadAssociations(dirs XDGDirs) (*Associations, error) {
+	a := &Associations{apps: make(map[string]*Application)}
+	for _, dir := range dirs.ApplicationDirs() {
+		if err := a.loadApplications(dir); err != nil {
+			return nil, err
+		}
+	}
+	for _, path := range dirs.MimeAppsLists() {
+		groups, err := readKeyFile(path)
+		if skippable(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		a.lists = append(a.lists, mimeAppsList{
+			defaults: typeLists(groups["Default Applications"]),
+			added:    typeLists(groups["Added Associations"]),
+			removed:  typeLists(groups["Removed Associations"]),
+		})
+	}
+	return a, nil
+}
+
+func skippable(err error) bool {
+	return os.IsNotExist(err) || os.IsPermission(err)
+}
+
+// loadApplications reads the desktop entries in dir. Entries
+// installed in a directory of higher precedence, including hidden
+// ones, shadow the ones with the same ID.
+func (a *Associations) loadApplications(dir string) error {
+	var ids []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if skippable(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".desktop") {
+			return nil
+		}
+		rel, _ := filepath.Rel(dir, path)
+		id := strings.Replace(filepath.ToSlash(rel), "/", "-", -1)
+		if _, ok := a.apps[id]; ok {
+			return nil
+		}
+		groups, err := readKeyFile(path)
+		if skippable(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		entry := groups["Desktop Entry"]
+		if entry == nil || entry["Hidden"] == "true" {
+			a.apps[id] = nil
+			return nil
+		}
+		app := &Application{
+			ID:   id,
+			Path: path,
+			Name: entry["Name"],
+			Exec: entry["Exec"],
+			Icon: entry["Icon"],
+		}
+		for _, t := range splitList(entry["MimeType"]) {
+			app.MediaTypes = append(app.MediaTypes, canonicalName(t))
+		}
+		a.apps[id] = app
+		ids = append(ids, id)
+		return nil
+	})
+	sort.Strings(ids)
+	a.ids = append(a.ids, ids...)
+	return err
+}
+
+// Application returns the installed application with the desktop
+// file ID.
+func (a *Associations) Application(id string) (Application, bool) {
+	if app := a.apps[id]; app != nil {
+		return *app, true
+	}
+	return Application{}, false
+}
+
+// Applications returns the installed applications that can open
+// files of MIME type m, in order of preference: the ones associated
+// with m itself, and then the ones associated with the types it's a
+// subclass of, closest first. Applications whose association has
+// been removed are left out.
+func (a *Associations) Applications(m MediaType) []Application {
+	var apps []Application
+	seen := make(map[string]bool)
+	for _, t := range superTypes(m) {
+		for _, id := range a.associated(t) {
+			if !seen[id] {
+				seen[id] = true
+				apps = append(apps, *a.apps[id])
+			}
+		}
+	}
+	return apps
+}
+
+// Default returns the default application for files of MIME type
+// m: the first installed application set as the default for m, or
+// failing that for the closest type it's a subclass of, in the
+// mimeapps.list of highest precedence that sets one. Without any,
+// it's the first of the Applications for m.
+func (a *Associations) Default(m MediaType) (Application, bool) {
+	for _, t := range superTypes(m) {
+		removed := make(map[string]bool)
+		for _, l := range a.lists {
+			for _, id := range l.defaults[t] {
+				if a.apps[id] != nil && !removed[id] {
+					return *a.apps[id], true
+				}
+			}
+			for _, id := range l.removed[t] {
+				removed[id] = true
+			}
+		}
+	}
+	if apps := a.Applications(m); len(apps) > 0 {
+		return apps[0], true
+	}
+	return Application{}, false
+}
+
+// associated returns the IDs of the installed applications
+// associated with the MIME type t, either by the mimeapps.list
+// files or by their desktop entries, and not removed by a
+// mimeapps.list of higher precedence.
+func (a *Associations) associated(t string) []string {
+	var ids []string
+	removed := make(map[string]bool)
+	for _, l := range a.lists {
+		for _, id := range l.added[t] {
+			if a.apps[id] != nil && !removed[id] {
+				ids = append(ids, id)
+			}
+		}
+		for _, id := range l.removed[t] {
+			removed[id] = true
+		}
+	}
+	for _, id := range a.ids {
+		for _, mt := range a.apps[id].MediaTypes {
+			if mt == t && !removed[id] {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// superTypes returns the canonical names of m and of the types it's
+// a subclass of, breadth first.
+func superTypes(m MediaType) []string {
+	i := lookup(m.MediaType())
+	if i < 0 {
+		return []string{m.MediaType()}
+	}
+	var names []string
+	queue, seen := []int{i}, map[int]bool{i: true}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		names = append(names, mediaTypes[t].MediaType())
+		for _, p := range mediaTypes[t].subClassOf {
+			if !seen[p] {
+				seen[p] = true
+				queue = append(queue, p)
+			}
+		}
+	}
+	return names
+}
+
+// canonicalName resolves an alias to the MIME type it stands for.
+func canonicalName(name string) string {
+	if i := lookup(name); i > -1 {
+		return mediaTypes[i].MediaType()
+	}
+	return name
+}
+
+func typeLists(group map[string]string) map[string][]string {
+	lists := make(map[string][]string, len(group))
+	for t, value := range group {
+		t = canonicalName(t)
+		lists[t] = append(lists[t], splitList(value)...)
+	}
+	return lists
+}
+
+// readKeyFile parses a file in the desktop entry format into its
+// groups of keys. Localised keys are kept as they are.
+func readKeyFile(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	groups := make(map[string]map[string]string)
+	var group map[string]string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case line == "" || line[0] == '#':
+		case line[0] == '[' && line[len(line)-1] == ']':
+			name := line[1 : len(line)-1]
+			if groups[name] == nil {
+				groups[name] = make(map[string]string)
+			}
+			group = groups[name]
+		case group != nil:
+			if i := strings.IndexByte(line, '='); i > -1 {
+				group[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+			}
+		}
+	}
+	return groups, s.Err()
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, s := range strings.Split(value, ";") {
+		if s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
@@ -0,0 +1,88 @@
+package mimemagic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAssociations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apps")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dirs := XDGDirs{
+		ConfigHome: filepath.Join(dir, "config"),
+		DataHome:   filepath.Join(dir, "data"),
+		ConfigDirs: []string{filepath.Join(dir, "etc")},
+		DataDirs:   []string{filepath.Join(dir, "usr"), filepath.Join(dir, "missing")},
+		Desktops:   []string{"kde"},
+	}
+	files := map[string]string{
+		"data/applications/kde4/viewer.desktop": "[Desktop Entry]\nName=Viewer\nMimeType=image/png;image/jpeg;\n",
+		"data/applications/hidden.desktop":      "[Desktop Entry]\nHidden=true\n",
+		"usr/applications/hidden.desktop":       "[Desktop Entry]\nName=Hidden\nMimeType=image/png;\n",
+		"usr/applications/editor.desktop":       "[Desktop Entry]\nName=Editor\nExec=editor %f\nMimeType=text/plain;\n",
+		"usr/applications/archiver.desktop":     "# An alias.\n[Desktop Entry]\nName=Archiver\nMimeType=application/x-gzip;\n",
+		"usr/applications/ide.desktop":          "[Desktop Entry]\nName=IDE\nMimeType=text/x-go;\n",
+		"usr/applications/paint.desktop":        "[Desktop Entry]\nName=Paint\nMimeType=image/png;\n",
+		"usr/applications/browser.desktop":      "[Desktop Entry]\nName=Browser\nMimeType=x-scheme-handler/https;\n",
+		"etc/mimeapps.list": "[Default Applications]\nimage/png=missing.desktop;paint.desktop;\n" +
+			"text/plain=editor.desktop\n\n[Removed Associations]\nimage/jpeg=kde4-viewer.desktop\n",
+		"config/kde-mimeapps.list": "[Removed Associations]\nimage/png=paint.desktop;\n",
+		"config/mimeapps.list":     "[Added Associations]\nimage/jpeg=paint.desktop;\n",
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("MkdirAll() error = %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	a, err := LoadAssociations(dirs)
+	if err != nil {
+		t.Fatalf("LoadAssociations() error = %v", err)
+	}
+	ids := func(apps []Application) []string {
+		var ids []string
+		for _, app := range apps {
+			ids = append(ids, app.ID)
+		}
+		return ids
+	}
+	tests := []struct {
+		mediaType    MediaType
+		applications []string
+		def          string
+	}{
+		{mediaTypes[lookup("image/png")], []string{"kde4-viewer.desktop"}, "kde4-viewer.desktop"},
+		{mediaTypes[lookup("image/jpeg")], []string{"paint.desktop"}, "paint.desktop"},
+		{mediaTypes[lookup("text/plain")], []string{"editor.desktop"}, "editor.desktop"},
+		{mediaTypes[lookup("text/x-go")], []string{"ide.desktop", "editor.desktop"}, "editor.desktop"},
+		{mediaTypes[lookup("application/gzip")], []string{"archiver.desktop"}, "archiver.desktop"},
+		{MediaType{Media: "x-scheme-handler", Subtype: "https"}, []string{"browser.desktop"}, "browser.desktop"},
+		{mediaTypes[lookup("audio/mpeg")], nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mediaType.MediaType(), func(t *testing.T) {
+			if got := ids(a.Applications(tt.mediaType)); !reflect.DeepEqual(got, tt.applications) {
+				t.Errorf("Applications() = %v, want %v", got, tt.applications)
+			}
+			got, ok := a.Default(tt.mediaType)
+			if got.ID != tt.def || ok != (tt.def != "") {
+				t.Errorf("Default() = %v, %v, want %v", got.ID, ok, tt.def)
+			}
+		})
+	}
+	if app, ok := a.Application("editor.desktop"); !ok || app.Name != "Editor" || app.Exec != "editor %f" {
+		t.Errorf("Application() = %+v, %v", app, ok)
+	}
+	if _, ok := a.Application("hidden.desktop"); ok {
+		t.Error("Application() found a hidden application")
+	}
+}
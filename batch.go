@@ -0,0 +1,140 @@
+package mimemagic
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// BatchOptions configures MatchPaths.
+// Workers is the number of files examined in parallel,
+// defaulting to GOMAXPROCS. If Ordered is set, results are
+// delivered in the order the paths were received, otherwise as
+// soon as they're ready. FollowSymlinks, Limit and Preference
+// are as in MatchPath, with a non-positive Limit reading up to
+// the longest magic signature.
+type BatchOptions struct {
+	Workers        int
+	Ordered        bool
+	FollowSymlinks bool
+	Limit          int
+	Preference     int
+}
+
+// BatchResult is the outcome of matching a single path with
+// MatchPaths. Err is the error MatchPath would have returned
+// for it, with MediaType set as it would have been. Size is the
+// size of the file, if it could be stat'd.
+type BatchResult struct {
+	Path      string
+	MediaType MediaType
+	Size      int64
+	Err       error
+}
+
+// MatchPaths determines the MIME types of the files received on
+// paths in parallel, as MatchPath does, and delivers a result
+// for each of them on the returned channel, which is closed once
+// paths is closed and every result has been delivered. An error
+// with one file doesn't affect the others. Once ctx is done, no
+// more paths are received, results that haven't been delivered
+// yet are dropped, and the channel is closed as soon as the
+// files being examined are done with.
+func MatchPaths(ctx context.Context, paths <-chan string, opts BatchOptions) <-chan BatchResult {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+	limit, preference := limitAndPreference([]int{limit, opts.Preference})
+	type job struct {
+		path string
+		out  chan<- BatchResult
+	}
+	results := make(chan BatchResult, workers)
+	jobs := make(chan job)
+	// In order, every job gets a channel of its own, which are
+	// queued up to be emptied into results one at a time. The
+	// length of the queue limits how far ahead the workers can
+	// get of a slow file.
+	var queue chan chan BatchResult
+	if opts.Ordered {
+		queue = make(chan chan BatchResult, 4*workers)
+	}
+	go func() {
+		defer close(jobs)
+		if queue != nil {
+			defer close(queue)
+		}
+		for {
+			var path string
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case path, ok = <-paths:
+				if !ok {
+					return
+				}
+			}
+			j := job{path, results}
+			if queue != nil {
+				out := make(chan BatchResult, 1)
+				select {
+				case queue <- out:
+				case <-ctx.Done():
+					return
+				}
+				j.out = out
+			}
+			select {
+			case jobs <- j:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				r := BatchResult{Path: j.path}
+				m, info, err := matchPath(j.path, opts.FollowSymlinks, true, limit, preference)
+				r.MediaType, r.Err = mediaTypes[m], err
+				if info != nil {
+					r.Size = info.Size()
+				}
+				select {
+				case j.out <- r:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(results)
+		defer wg.Wait()
+		if queue == nil {
+			return
+		}
+		for out := range queue {
+			select {
+			case r := <-out:
+				select {
+				case results <- r:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return results
+}
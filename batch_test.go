@@ -0,0 +1,148 @@
+package mimemagic
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func batchFiles(t testing.TB, n int) (paths, want []string) {
+	dir, err := ioutil.TempDir("", "batch")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	files := []struct {
+		ext, data, want string
+	}{
+		{"", string(pngHeader), "image/png"},
+		{"", epubHeader, "application/epub+zip"},
+		{".txt", "hello", "text/plain"},
+		{".bin", elfHeader, "application/x-executable"},
+	}
+	for i := 0; i < n; i++ {
+		f := files[i%len(files)]
+		p := filepath.Join(dir, fmt.Sprintf("%d%s", i, f.ext))
+		if err := ioutil.WriteFile(p, []byte(f.data), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		paths, want = append(paths, p), append(want, f.want)
+	}
+	return
+}
+
+func feed(paths []string) <-chan string {
+	c := make(chan string)
+	go func() {
+		for _, p := range paths {
+			c <- p
+		}
+		close(c)
+	}()
+	return c
+}
+
+func TestMatchPaths(t *testing.T) {
+	paths, want := batchFiles(t, 64)
+	defer os.RemoveAll(filepath.Dir(paths[0]))
+	paths = append(paths, filepath.Join(filepath.Dir(paths[0]), "missing"))
+	want = append(want, "application/octet-stream")
+	for _, workers := range []int{0, 1, 7} {
+		t.Run(fmt.Sprintf("ordered %d", workers), func(t *testing.T) {
+			i := 0
+			for r := range MatchPaths(context.Background(), feed(paths), BatchOptions{Workers: workers, Ordered: true}) {
+				if r.Path != paths[i] {
+					t.Fatalf("MatchPaths()[%d].Path = %v, want %v", i, r.Path, paths[i])
+				}
+				if r.MediaType.MediaType() != want[i] {
+					t.Errorf("MatchPaths()[%d] = %v, want %v", i, r.MediaType.MediaType(), want[i])
+				}
+				if info, err := os.Stat(r.Path); err == nil && r.Size != info.Size() {
+					t.Errorf("MatchPaths()[%d].Size = %v, want %v", i, r.Size, info.Size())
+				}
+				if (r.Err != nil) != (i == len(paths)-1) {
+					t.Errorf("MatchPaths()[%d].Err = %v", i, r.Err)
+				}
+				i++
+			}
+			if i != len(paths) {
+				t.Errorf("MatchPaths() delivered %d results, want %d", i, len(paths))
+			}
+		})
+		t.Run(fmt.Sprintf("unordered %d", workers), func(t *testing.T) {
+			got := make(map[string]string)
+			for r := range MatchPaths(context.Background(), feed(paths), BatchOptions{Workers: workers}) {
+				got[r.Path] = r.MediaType.MediaType()
+			}
+			if len(got) != len(paths) {
+				t.Errorf("MatchPaths() delivered %d results, want %d", len(got), len(paths))
+			}
+			for i, p := range paths {
+				if got[p] != want[i] {
+					t.Errorf("MatchPaths()[%s] = %v, want %v", p, got[p], want[i])
+				}
+			}
+		})
+	}
+	t.Run("options", func(t *testing.T) {
+		r := <-MatchPaths(context.Background(), feed(paths[:1]), BatchOptions{Limit: 1})
+		if m, _ := MatchPath(paths[0], false, 1); r.MediaType.MediaType() != m.MediaType() || m.MediaType() == want[0] {
+			t.Errorf("MatchPaths() = %v, want %v", r.MediaType.MediaType(), m.MediaType())
+		}
+		p := filepath.Join(filepath.Dir(paths[0]), "image.txt")
+		if err := ioutil.WriteFile(p, pngHeader, 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		r = <-MatchPaths(context.Background(), feed([]string{p}), BatchOptions{Preference: Magic})
+		if r.MediaType.MediaType() != "image/png" {
+			t.Errorf("MatchPaths() = %v, want image/png", r.MediaType.MediaType())
+		}
+	})
+	for _, ordered := range []bool{false, true} {
+		t.Run(fmt.Sprintf("cancel ordered %v", ordered), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			endless := make(chan string)
+			go func() {
+				for i := 0; ; i++ {
+					select {
+					case endless <- paths[i%len(paths)]:
+					case <-time.After(5 * time.Second):
+						return
+					}
+				}
+			}()
+			results := MatchPaths(ctx, endless, BatchOptions{Workers: 4, Ordered: ordered})
+			for i := 0; i < 10; i++ {
+				<-results
+			}
+			cancel()
+			timeout := time.After(5 * time.Second)
+			for {
+				select {
+				case _, ok := <-results:
+					if !ok {
+						return
+					}
+				case <-timeout:
+					t.Fatal("MatchPaths() didn't close the results after cancellation")
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMatchPaths(b *testing.B) {
+	paths, _ := batchFiles(b, 256)
+	defer os.RemoveAll(filepath.Dir(paths[0]))
+	for _, workers := range []int{1, 4} {
+		b.Run(fmt.Sprintf("workers %d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for range MatchPaths(context.Background(), feed(paths), BatchOptions{Workers: workers}) {
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+const batchFlag = "Can't be used in conjunction with -c, -f, -s, -t or -x."
+
+// patterns is a flag that can be repeated.
+type patterns []string
+
+func (p *patterns) String() string { return strings.Join(*p, ", ") }
+
+func (p *patterns) Set(s string) error {
+	*p = append(*p, s)
+	return nil
+}
+
+func (p patterns) match(name string) bool {
+	for _, pattern := range p {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePatterns() error {
+	for _, p := range append(include, exclude...) {
+		if _, err := filepath.Match(p, ""); err != nil {
+			return fmt.Errorf("%v: %s", err, p)
+		}
+	}
+	return nil
+}
+
+// selected reports whether the file name passes the -include and
+// -exclude filters.
+func selected(name string) bool {
+	return (len(include) == 0 || include.match(name)) && !exclude.match(name)
+}
+
+// walk sends the files to examine on paths, descending into
+// directories with -r.
+func walk(paths chan<- string) {
+	defer close(paths)
+	for _, filename := range files {
+		info, err := os.Stat(filename)
+		if !recursive || err != nil || !info.IsDir() {
+			if selected(filepath.Base(filename)) {
+				paths <- filename
+			}
+			continue
+		}
+		filepath.WalkDir(filename, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				printError(err)
+				return nil
+			}
+			if d.IsDir() {
+				if path != filename && exclude.match(d.Name()) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if selected(d.Name()) {
+				paths <- path
+			}
+			return nil
+		})
+	}
+}
+
+type tally struct {
+	name  string
+	count int
+	bytes int64
+}
+
+func identifyBatch() {
+	if err := validatePatterns(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(2)
+	}
+	paths := make(chan string)
+	go walk(paths)
+	opts := mimemagic.BatchOptions{
+		Workers:        jobs,
+		Ordered:        true,
+		FollowSymlinks: true,
+		Limit:          limit,
+		Preference:     preference,
+	}
+	tallies := make(map[string]*tally)
+	for r := range mimemagic.MatchPaths(context.Background(), paths, opts) {
+		if !summary {
+			if r.Err == nil && !ofType(r.MediaType) {
+				continue
+			}
+			report(r.Path, r.MediaType, detectionMethod(r.Path, r.MediaType), r.Err)
+			continue
+		}
+		if printError(r.Err) || !ofType(r.MediaType) {
+			continue
+		}
+		name := r.MediaType.MediaType()
+		if humanReadable {
+			name = r.MediaType.Comment
+		}
+		t, ok := tallies[name]
+		if !ok {
+			t = &tally{name: name}
+			tallies[name] = t
+		}
+		t.count++
+		t.bytes += r.Size
+	}
+	if summary {
+		printSummary(tallies)
+	}
+}
+
+// ofType reports whether m passes the -type filter.
+func ofType(m mimemagic.MediaType) bool {
+	for _, t := range types {
+		if m.Is(t) {
+			return true
+		}
+	}
+	return len(types) == 0
+}
+
+func printSummary(tallies map[string]*tally) {
+	sorted := make([]*tally, 0, len(tallies))
+	total := tally{name: "total"}
+	for _, t := range tallies {
+		sorted = append(sorted, t)
+		total.count += t.count
+		total.bytes += t.bytes
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].count != sorted[j].count {
+			return sorted[i].count > sorted[j].count
+		}
+		return sorted[i].name < sorted[j].name
+	})
+	for _, t := range append(sorted, &total) {
+		fmt.Printf("%8d %14d  %s\n", t.count, t.bytes, t.name)
+	}
+}
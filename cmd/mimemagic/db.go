@@ -0,0 +1,211 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+func dbUsage() {
+	fmt.Fprint(os.Stderr, "Usage: mimemagic db list\n"+
+		"       mimemagic db show <mimetype>\n"+
+		"       mimemagic db ext <.ext>\n"+
+		"       mimemagic db magic <mimetype>\n"+
+		"Inspects the MIME type database compiled into mimemagic.\n\n"+
+		"Commands:\n"+
+		"  list\n"+
+		"    \tOutput every MIME type along with its description, separated by a\n"+
+		"    \ttab.\n"+
+		"  show\n"+
+		"    \tOutput everything known about the MIME type: its description,\n"+
+		"    \taliases, parents, children, glob patterns with their weights,\n"+
+		"    \tmagic signatures, XML root elements and tree magic signatures.\n"+
+		"  ext\n"+
+		"    \tOutput the MIME types whose glob patterns match the extension, by\n"+
+		"    \tdescending weight.\n"+
+		"  magic\n"+
+		"    \tOutput the magic signatures of the MIME type as shared-mime-info XML\n"+
+		"    \t<magic> elements, in the order they're checked in.\n")
+}
+
+func db(args []string) {
+	if len(args) < 1 {
+		dbUsage()
+		os.Exit(2)
+	}
+	switch {
+	case args[0] == "list" && len(args) == 1:
+		dbList()
+	case args[0] == "show" && len(args) == 2:
+		dbShow(args[1])
+	case args[0] == "ext" && len(args) == 2:
+		dbExt(args[1])
+	case args[0] == "magic" && len(args) == 2:
+		dbMagic(args[1])
+	default:
+		dbUsage()
+		os.Exit(2)
+	}
+}
+
+func dbList() {
+	for _, m := range mimemagic.MediaTypes() {
+		fmt.Println(m.MediaType() + "\t" + m.Comment)
+	}
+}
+
+func dbShow(name string) {
+	m, ok := mimemagic.Lookup(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown MIME type '%s'\n", name)
+		os.Exit(1)
+	}
+	fmt.Println(m.MediaType())
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Printf("  %-14s%s\n", name+":", value)
+		}
+	}
+	field("Comment", m.Comment)
+	if m.ExpandedAcronym != "" {
+		field("Acronym", m.Acronym+" ("+m.ExpandedAcronym+")")
+	} else {
+		field("Acronym", m.Acronym)
+	}
+	field("Aliases", strings.Join(m.Alias, ", "))
+	field("Parents", strings.Join(m.SubClassOf, ", "))
+	var children []string
+	for _, c := range m.Subclasses() {
+		children = append(children, c.MediaType())
+	}
+	field("Children", strings.Join(children, ", "))
+	field("Icon", m.Icon)
+	field("Generic icon", m.GenericIcon)
+	if globs := m.Globs(); len(globs) > 0 {
+		fmt.Println("  Globs:")
+		for _, g := range globs {
+			fmt.Printf("    %s (weight %d%s)\n", g.Pattern, g.Weight, caseSensitive(g.CaseSensitive))
+		}
+	}
+	if signatures := m.Magic(); len(signatures) > 0 {
+		fmt.Println("  Magic:")
+		for i, s := range signatures {
+			fmt.Printf("    #%d\n", i+1)
+			printMagicRules(s.Rules, 3)
+		}
+	}
+	if roots := m.XMLRoots(); len(roots) > 0 {
+		fmt.Println("  Root XML:")
+		for _, r := range roots {
+			fmt.Printf("    namespace %q, local name %q\n", r.NamespaceURI, r.LocalName)
+		}
+	}
+	if signatures := m.TreeMagic(); len(signatures) > 0 {
+		fmt.Println("  Tree magic:")
+		for _, s := range signatures {
+			fmt.Printf("    priority %d\n", s.Priority)
+			printTreeMagicRules(s.Rules, 3)
+		}
+	}
+}
+
+func caseSensitive(b bool) string {
+	if b {
+		return ", case-sensitive"
+	}
+	return ""
+}
+
+// printMagicRules outputs the rules, indented by depth, nested
+// rules underneath the rule they follow.
+func printMagicRules(rules []mimemagic.MagicRule, depth int) {
+	for _, r := range rules {
+		offset := fmt.Sprint(r.Offset)
+		if r.Range > 0 {
+			offset = fmt.Sprintf("%d:%d", r.Offset, r.Offset+r.Range)
+		}
+		out := fmt.Sprintf("%s[%s] %q", strings.Repeat("  ", depth), offset, r.Value)
+		if r.Mask != nil {
+			out += fmt.Sprintf(" & %#x", r.Mask)
+		}
+		fmt.Println(out)
+		printMagicRules(r.Next, depth+1)
+	}
+}
+
+func printTreeMagicRules(rules []mimemagic.TreeMagicRule, depth int) {
+	for _, r := range rules {
+		var attrs []string
+		for _, a := range []struct {
+			set  bool
+			attr string
+		}{
+			{r.Type != "", r.Type},
+			{r.MatchCase, "match-case"},
+			{r.Executable, "executable"},
+			{r.NonEmpty, "non-empty"},
+			{r.MediaType != "", r.MediaType},
+		} {
+			if a.set {
+				attrs = append(attrs, a.attr)
+			}
+		}
+		out := strings.Repeat("  ", depth) + r.Path
+		if len(attrs) > 0 {
+			out += " (" + strings.Join(attrs, ", ") + ")"
+		}
+		fmt.Println(out)
+		printTreeMagicRules(r.Next, depth+1)
+	}
+}
+
+func dbExt(ext string) {
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	type match struct {
+		mimemagic.MediaType
+		mimemagic.GlobPattern
+	}
+	var matches []match
+	name := "file" + ext
+	for _, m := range mimemagic.MediaTypes() {
+		for _, g := range m.Globs() {
+			pattern, s := g.Pattern, name
+			if !g.CaseSensitive {
+				pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+			}
+			if ok, _ := filepath.Match(pattern, s); ok {
+				matches = append(matches, match{m, g})
+				break
+			}
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Weight > matches[j].Weight })
+	for _, m := range matches {
+		fmt.Printf("%s  %s (weight %d%s)\n", m.MediaType.MediaType(), m.Pattern, m.Weight, caseSensitive(m.CaseSensitive))
+	}
+	if len(matches) == 0 {
+		os.Exit(1)
+	}
+}
+
+func dbMagic(name string) {
+	m, ok := mimemagic.Lookup(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown MIME type '%s'\n", name)
+		os.Exit(1)
+	}
+	for _, s := range m.Magic() {
+		out, err := xml.MarshalIndent(s, "", "  ")
+		if err != nil {
+			printError(err)
+		}
+		fmt.Println(string(out))
+	}
+}
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+// fileHeadLen is how much of a file is read in file(1) mode, which
+// has to be enough to tell its character encoding.
+const fileHeadLen = 1 << 16
+
+// fileOptions are the file(1) flags the compatibility mode accepts.
+type fileOptions struct {
+	brief, mimeType, mimeEncoding, follow, uncompress, keepGoing, print0 bool
+	names                                                                []string
+}
+
+func fileUsage() {
+	fmt.Fprint(os.Stderr, "Usage: mimemagic file [-bhiLkz0] [--mime-type] [--mime-encoding] [-f namefile] <file> ...\n"+
+		"Determines the type of the given file(s) by their content, with the\n"+
+		"output and flags of file(1), so that it can stand in for it. Also used\n"+
+		"when mimemagic is invoked as file.\n\n"+
+		"Options:\n"+
+		"  -b, --brief\n"+
+		"    \tDon't prepend the file name.\n"+
+		"  -i, --mime\n"+
+		"    \tOutput the MIME type and the character encoding, such as\n"+
+		"    \t'text/plain; charset=us-ascii'.\n"+
+		"  --mime-type, --mime-encoding\n"+
+		"    \tOutput only the MIME type, or the character encoding.\n"+
+		"  -L, --dereference\n"+
+		"    \tFollow symbolic links. The default if POSIXLY_CORRECT is set.\n"+
+		"  -h, --no-dereference\n"+
+		"    \tDon't follow symbolic links. The default otherwise.\n"+
+		"  -z, --uncompress\n"+
+		"    \tLook inside gzip and bzip2 compressed files.\n"+
+		"  -k, --keep-going\n"+
+		"    \tOutput every unrelated type the file is valid as, each on a line\n"+
+		"    \tof its own starting with '- '.\n"+
+		"  -f, --files-from namefile\n"+
+		"    \tRead the names of the files to examine from namefile, one per\n"+
+		"    \tline, before the arguments. '-' to read from stdin.\n"+
+		"  -0, --print0\n"+
+		"    \tOutput a NUL character after the file name.\n")
+}
+
+// parseFileArgs parses file(1) style arguments, where short flags
+// can be combined and long ones take their value either after '='
+// or as the next argument.
+func parseFileArgs(args []string) (opts fileOptions, err error) {
+	opts.follow = os.Getenv("POSIXLY_CORRECT") != ""
+	var nameFiles []string
+	for len(args) > 0 {
+		arg := args[0]
+		args = args[1:]
+		switch {
+		case arg == "--":
+			opts.names = append(opts.names, args...)
+			args = nil
+		case strings.HasPrefix(arg, "--"):
+			name, value, hasValue := arg[2:], "", false
+			if i := strings.IndexByte(name, '='); i > -1 {
+				name, value, hasValue = name[:i], name[i+1:], true
+			}
+			if name == "files-from" {
+				if !hasValue {
+					if len(args) == 0 {
+						return opts, fmt.Errorf("option '--%s' requires an argument", name)
+					}
+					value, args = args[0], args[1:]
+				}
+				nameFiles = append(nameFiles, value)
+				continue
+			}
+			if hasValue {
+				return opts, fmt.Errorf("option '--%s' doesn't allow an argument", name)
+			}
+			if !opts.setLong(name) {
+				return opts, fmt.Errorf("unrecognized option '--%s'", name)
+			}
+		case len(arg) > 1 && arg[0] == '-':
+			for i := 1; i < len(arg); i++ {
+				if arg[i] == 'f' {
+					value := arg[i+1:]
+					if value == "" {
+						if len(args) == 0 {
+							return opts, fmt.Errorf("option requires an argument -- 'f'")
+						}
+						value, args = args[0], args[1:]
+					}
+					nameFiles = append(nameFiles, value)
+					break
+				}
+				if !opts.setShort(arg[i]) {
+					return opts, fmt.Errorf("invalid option -- '%c'", arg[i])
+				}
+			}
+		default:
+			opts.names = append(opts.names, arg)
+		}
+	}
+	var names []string
+	for _, nameFile := range nameFiles {
+		n, err := readNames(nameFile)
+		if err != nil {
+			return opts, err
+		}
+		names = append(names, n...)
+	}
+	opts.names = append(names, opts.names...)
+	return opts, nil
+}
+
+func (o *fileOptions) setShort(c byte) bool {
+	switch c {
+	case 'b':
+		o.brief = true
+	case 'i':
+		o.mimeType, o.mimeEncoding = true, true
+	case 'L':
+		o.follow = true
+	case 'h':
+		o.follow = false
+	case 'z':
+		o.uncompress = true
+	case 'k':
+		o.keepGoing = true
+	case '0':
+		o.print0 = true
+	default:
+		return false
+	}
+	return true
+}
+
+func (o *fileOptions) setLong(name string) bool {
+	switch name {
+	case "brief":
+		o.brief = true
+	case "mime":
+		o.mimeType, o.mimeEncoding = true, true
+	case "mime-type":
+		o.mimeType = true
+	case "mime-encoding":
+		o.mimeEncoding = true
+	case "dereference":
+		o.follow = true
+	case "no-dereference":
+		o.follow = false
+	case "uncompress":
+		o.uncompress = true
+	case "keep-going":
+		o.keepGoing = true
+	case "print0":
+		o.print0 = true
+	default:
+		return false
+	}
+	return true
+}
+
+func readNames(nameFile string) ([]string, error) {
+	r := os.Stdin
+	if nameFile != "-" {
+		f, err := os.Open(nameFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var names []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if s.Text() != "" {
+			names = append(names, s.Text())
+		}
+	}
+	return names, s.Err()
+}
+
+func fileMode(args []string) {
+	opts, err := parseFileArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mimemagic: %v\n", err)
+		fileUsage()
+		os.Exit(1)
+	}
+	if len(opts.names) == 0 {
+		fileUsage()
+		os.Exit(1)
+	}
+	// The descriptions are aligned, as file(1) does.
+	width := 0
+	for _, name := range opts.names {
+		if n := utf8.RuneCountInString(displayName(name)); n > width {
+			width = n
+		}
+	}
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	for _, name := range opts.names {
+		desc := opts.describe(name)
+		if !opts.brief {
+			display := displayName(name)
+			w.WriteString(display)
+			if opts.print0 {
+				w.WriteByte(0)
+			}
+			fmt.Fprintf(w, ":%*s ", width-utf8.RuneCountInString(display), "")
+		}
+		w.WriteString(desc + "\n")
+	}
+}
+
+func displayName(name string) string {
+	if name == "-" {
+		return "/dev/stdin"
+	}
+	return name
+}
+
+// describe returns what file(1) would output for the file, sans
+// its name.
+func (o fileOptions) describe(name string) string {
+	if name == "-" {
+		data, err := readHead(os.Stdin)
+		if err != nil {
+			return fmt.Sprintf("ERROR: cannot read `%s' (%s)", displayName(name), errorText(err))
+		}
+		return o.describeData(data)
+	}
+	info, err := os.Lstat(name)
+	if err == nil && o.follow {
+		info, err = os.Stat(name)
+	}
+	if err != nil {
+		return fmt.Sprintf("cannot open `%s' (%s)", name, errorText(err))
+	}
+	if !info.Mode().IsRegular() {
+		m, err := mimemagic.MatchPath(name, o.follow)
+		if err != nil {
+			return fmt.Sprintf("cannot open `%s' (%s)", name, errorText(err))
+		}
+		if m.MediaType() == "inode/symlink" && !o.mimeType && !o.mimeEncoding {
+			target, _ := os.Readlink(name)
+			return "symbolic link to " + target
+		}
+		return o.format([]mimemagic.MediaType{m}, "binary", nil, "")
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return fmt.Sprintf("cannot open `%s' (%s)", name, errorText(err))
+	}
+	defer f.Close()
+	data, err := readHead(f)
+	if err != nil {
+		return fmt.Sprintf("ERROR: cannot read `%s' (%s)", name, errorText(err))
+	}
+	return o.describeData(data)
+}
+
+func (o fileOptions) describeData(data []byte) string {
+	types := []mimemagic.MediaType{mimemagic.MatchMagic(data)}
+	if o.keepGoing {
+		if all := mimemagic.MatchPolyglot(data); len(all) > 1 {
+			types = all
+		}
+	}
+	if o.uncompress {
+		if inner, ok := decompress(types[0], data); ok {
+			m := mimemagic.MatchMagic(inner)
+			return o.format([]mimemagic.MediaType{m}, encoding(inner), &types[0], "binary")
+		}
+	}
+	return o.format(types, encoding(data), nil, "")
+}
+
+// format formats the MIME types, along with the compression of
+// the file if it was looked inside, as requested.
+func (o fileOptions) format(types []mimemagic.MediaType, charset string, outer *mimemagic.MediaType,
+	outerCharset string) string {
+	out := make([]string, len(types))
+	for i, m := range types {
+		switch {
+		case o.mimeType && o.mimeEncoding:
+			out[i] = m.MediaType() + "; charset=" + charset
+			if outer != nil {
+				out[i] += " compressed-encoding=" + outer.MediaType() + "; charset=" + outerCharset
+			}
+		case o.mimeType:
+			out[i] = m.MediaType()
+		case o.mimeEncoding:
+			out[i] = charset
+		default:
+			out[i] = m.Comment
+			if outer != nil {
+				out[i] += " (" + outer.Comment + ")"
+			}
+		}
+	}
+	return strings.Join(out, "\n- ")
+}
+
+func readHead(r io.Reader) ([]byte, error) {
+	data := make([]byte, fileHeadLen)
+	n, err := io.ReadFull(r, data)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return data[:n], err
+}
+
+// decompress returns the beginning of the decompressed contents
+// of the file, if it's compressed in a format it can read.
+func decompress(m mimemagic.MediaType, data []byte) ([]byte, bool) {
+	var r io.Reader
+	switch {
+	case m.Is("application/gzip"):
+		z, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, false
+		}
+		r = z
+	case m.Is("application/x-bzip"):
+		r = bzip2.NewReader(bytes.NewReader(data))
+	default:
+		return nil, false
+	}
+	// Only the head of the file has been read, so the stream
+	// is likely to end abruptly.
+	inner, _ := readHead(r)
+	return inner, len(inner) > 0
+}
+
+// encoding guesses the character encoding of text the way file(1)
+// names it, returning "binary" for anything that isn't text.
+func encoding(data []byte) string {
+	switch {
+	case len(data) == 0:
+		return "binary"
+	case bytes.HasPrefix(data, []byte{0xfe, 0xff}):
+		return "utf-16be"
+	case bytes.HasPrefix(data, []byte{0xff, 0xfe}):
+		return "utf-16le"
+	}
+	ascii, latin1 := true, true
+	for _, b := range data {
+		switch {
+		case b < ' ' && b != '\t' && b != '\n' && b != '\r' && b != '\f' && b != '\v' && b != 0x1b, b == 0x7f:
+			return "binary"
+		case b >= 0x80 && b < 0xa0:
+			ascii, latin1 = false, false
+		case b >= 0x80:
+			ascii = false
+		}
+	}
+	switch {
+	case ascii:
+		return "us-ascii"
+	case utf8.Valid(trimPartialRune(data)):
+		return "utf-8"
+	case latin1:
+		return "iso-8859-1"
+	}
+	return "unknown-8bit"
+}
+
+// trimPartialRune drops a UTF-8 sequence cut short by the end of
+// the data read.
+func trimPartialRune(data []byte) []byte {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return data[:i]
+			}
+			break
+		}
+	}
+	return data
+}
+
+// errorText formats err the way file(1) does, as the capitalised
+// description of the underlying system error.
+func errorText(err error) string {
+	if pErr, ok := err.(*os.PathError); ok {
+		err = pErr.Err
+	}
+	s := err.Error()
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
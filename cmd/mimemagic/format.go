@@ -0,0 +1,224 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+var (
+	format       string
+	nulSeparated bool
+	records      recordWriter
+)
+
+// record is the machine-readable output for a single file.
+type record struct {
+	Path       string   `json:"path"`
+	Type       string   `json:"type"`
+	Comment    string   `json:"comment"`
+	Acronym    string   `json:"acronym"`
+	Aliases    []string `json:"aliases"`
+	Parents    []string `json:"parents"`
+	Extensions []string `json:"extensions"`
+	Icons      []string `json:"icons"`
+	Method     string   `json:"method"`
+	Error      string   `json:"error"`
+}
+
+var recordHeader = []string{
+	"path", "type", "comment", "acronym", "aliases", "parents", "extensions", "icons", "method", "error",
+}
+
+func newRecord(filename string, m mimemagic.MediaType, method string, err error) record {
+	if filename == "" {
+		filename = "-"
+	}
+	if err != nil {
+		return record{
+			Path: filename, Aliases: []string{}, Parents: []string{}, Extensions: []string{}, Icons: []string{},
+			Error: err.Error(),
+		}
+	}
+	r := record{
+		Path:       filename,
+		Type:       m.MediaType(),
+		Comment:    m.Comment,
+		Acronym:    m.Acronym,
+		Aliases:    append([]string{}, m.Alias...),
+		Parents:    append([]string{}, m.SubClassOf...),
+		Extensions: append([]string{}, m.Extensions...),
+		Icons:      []string{},
+		Method:     method,
+	}
+	for _, icon := range []string{m.Icon, m.GenericIcon} {
+		if icon != "" {
+			r.Icons = append(r.Icons, icon)
+		}
+	}
+	return r
+}
+
+func (r record) fields() []string {
+	return []string{
+		r.Path, r.Type, r.Comment, r.Acronym, strings.Join(r.Aliases, ","), strings.Join(r.Parents, ","),
+		strings.Join(r.Extensions, ","), strings.Join(r.Icons, ","), r.Method, r.Error,
+	}
+}
+
+type recordWriter interface {
+	write(r record)
+	close()
+}
+
+func newRecordWriter() recordWriter {
+	switch format {
+	case "json":
+		return &jsonWriter{}
+	case "ndjson":
+		return ndjsonWriter{}
+	case "csv":
+		w := csv.NewWriter(os.Stdout)
+		w.Write(recordHeader)
+		w.Flush()
+		return csvWriter{w}
+	case "tsv":
+		w := tsvWriter{}
+		w.writeFields(recordHeader)
+		return w
+	}
+	return nil
+}
+
+// jsonWriter streams a single array, so that its elements are
+// output as soon as they're ready.
+type jsonWriter struct {
+	n int
+}
+
+func (w *jsonWriter) write(r record) {
+	b, _ := json.Marshal(r)
+	if w.n == 0 {
+		fmt.Print("[\n  ")
+	} else {
+		fmt.Print(",\n  ")
+	}
+	os.Stdout.Write(b)
+	w.n++
+}
+
+func (w *jsonWriter) close() {
+	if w.n == 0 {
+		fmt.Println("[]")
+		return
+	}
+	fmt.Println("\n]")
+}
+
+type ndjsonWriter struct{}
+
+func (ndjsonWriter) write(r record) {
+	b, _ := json.Marshal(r)
+	os.Stdout.Write(append(b, terminator()))
+}
+
+func (ndjsonWriter) close() {}
+
+type csvWriter struct {
+	*csv.Writer
+}
+
+func (w csvWriter) write(r record) {
+	w.Write(r.fields())
+	w.Flush()
+}
+
+func (w csvWriter) close() {}
+
+// tsvWriter escapes tabs, newlines and backslashes within fields
+// rather than quoting them, so that every line is a record.
+type tsvWriter struct{}
+
+var tsvEscaper = strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r")
+
+func (w tsvWriter) write(r record) {
+	w.writeFields(r.fields())
+}
+
+func (tsvWriter) writeFields(fields []string) {
+	for i, f := range fields {
+		fields[i] = tsvEscaper.Replace(f)
+	}
+	os.Stdout.WriteString(strings.Join(fields, "\t") + string(terminator()))
+}
+
+func (tsvWriter) close() {}
+
+func terminator() byte {
+	if nulSeparated {
+		return 0
+	}
+	return '\n'
+}
+
+// validFormat reports whether -format and -0 are valid, and
+// compatible with the rest of the flags.
+func validFormat() bool {
+	switch format {
+	case "text":
+		return !nulSeparated || !summary
+	case "ndjson", "tsv":
+		return !consistency && !allTreeMagic && !summary
+	case "json", "csv":
+		return !consistency && !allTreeMagic && !summary && !nulSeparated
+	}
+	return false
+}
+
+// report outputs the MIME type m of the file, determined using
+// method, or err, in the format requested.
+func report(filename string, m mimemagic.MediaType, method string, err error) {
+	if records != nil {
+		if _, ok := err.(*os.PathError); ok || err == nil {
+			records.write(newRecord(filename, m, method, err))
+			return
+		}
+	}
+	if !printError(err) {
+		printMediaType(filename, m)
+	}
+}
+
+// detectionMethod guesses which of the methods MatchFile relies
+// upon determined m as the MIME type of the file.
+func detectionMethod(filename string, m mimemagic.MediaType) string {
+	switch {
+	case m.Media == "inode":
+		return "inode"
+	case filename == "":
+		return "magic"
+	}
+	if g := mimemagic.MatchGlob(filepath.Base(filename)); g.MediaType() == m.MediaType() &&
+		g.MediaType() != "application/octet-stream" {
+		return "glob"
+	}
+	return "magic"
+}
+
+// printLine outputs a line of text, prefixed by the file name
+// when there are several files.
+func printLine(filename, out string) {
+	sep := ": "
+	if nulSeparated {
+		sep = "\x00"
+	}
+	if prependFilename {
+		out = filename + sep + out
+	}
+	os.Stdout.WriteString(out + string(terminator()))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/zRedShift/mimemagic/v2"
 )
@@ -14,6 +15,9 @@ var (
 	filenameOnly    bool
 	xmlNamespace    bool
 	treeMagic       bool
+	allTreeMagic    bool
+	consistency     bool
+	mismatch        bool
 	humanReadable   bool
 	prependFilename bool
 	standardInput   bool
@@ -24,12 +28,32 @@ var (
 	input           *os.File
 	err             error
 	limit           int
+	jobs            int
+	recursive       bool
+	batch           bool
+	summary         bool
+	include         patterns
+	exclude         patterns
+	types           patterns
 	files           []string
 )
 
 func init() {
+	flag.BoolVar(&nulSeparated, "0", false,
+		"Terminate each file's output with a NUL character instead of a\n"+
+			"newline, also separating the file name from the MIME type with one in\n"+
+			"the text format. Can't be used in conjunction with -summary, or the\n"+
+			"json and csv formats.")
+	flag.BoolVar(&allTreeMagic, "a", false,
+		"Used with -t, output every MIME type the directory/mounted volume\n"+
+			"matches, separated by commas, ordered by priority.")
 	flag.BoolVar(&contentOnly, "c", false,
 		"Determine the MIME type of the file(s) using only its content.")
+	flag.StringVar(&format, "format", "text",
+		"The output `format`: text, json, ndjson, csv or tsv. Every format but\n"+
+			"text outputs the full path, MIME type, comment, acronym, aliases,\n"+
+			"parents, extensions, icons, detection method and error of each file.\n"+
+			"Can't be used in conjunction with -a, -s or -summary.")
 	flag.BoolVar(&humanReadable, "i", false,
 		"Output the MIME type in a human readable format.")
 	flag.BoolVar(&filenameOnly, "f", false,
@@ -38,6 +62,30 @@ func init() {
 	flag.BoolVar(&preferGlob, "g", false,
 		"While using both the content and the file name to determine the MIME\n"+
 			"type of the file, always defer to the file name in case of a contention.")
+	flag.Var(&exclude, "exclude",
+		"Skip the files, and with -r the directories, whose name matches the\n"+
+			"glob `pattern`. Can be repeated.\n"+
+			batchFlag)
+	flag.Var(&include, "include",
+		"Only examine the files whose name matches the glob `pattern`. Can be\n"+
+			"repeated.\n"+
+			batchFlag)
+	flag.IntVar(&jobs, "j", 1,
+		"The number of files to examine in parallel.\n"+
+			batchFlag)
+	flag.BoolVar(&recursive, "r", false,
+		"Examine every file within the directories given, recursively.\n"+
+			"Symbolic links to directories aren't followed.\n"+
+			batchFlag)
+	flag.BoolVar(&summary, "summary", false,
+		"Instead of the MIME type of every file, output the number of files\n"+
+			"and their total size in bytes for every MIME type.\n"+
+			batchFlag)
+	flag.Var(&types, "type",
+		"Only output the files of the given MIME `type`, including its aliases\n"+
+			"and subclasses, such as text/plain for text/x-go. 'image/*' matches\n"+
+			"every image. Can be repeated.\n"+
+			batchFlag)
 	flag.IntVar(&limit, "l", -1,
 		"The number of bytes from the beginning of the file mimemagic will\n"+
 			"examine. Reads the entire file if set to a negative value. By default\n"+
@@ -45,6 +93,11 @@ func init() {
 			"flag to a non-default negative value will override this.")
 	flag.BoolVar(&preferMagic, "m", false,
 		"Same as -g, but for content.")
+	flag.BoolVar(&consistency, "s", false,
+		"Check whether the file name of the file(s) agrees with its content,\n"+
+			"reporting the verdict along with both MIME types. Exits with status 1\n"+
+			"if any mismatch is found. Can't be used in conjunction with -c, -f, -t\n"+
+			"or -x.")
 	flag.BoolVar(&treeMagic, "t", false,
 		"Determine the MIME type of the directory/mounted volume using tree\n"+
 			"magic. Can't be used in conjunction with with -c, -f or -x.")
@@ -55,30 +108,93 @@ func init() {
 }
 
 func main() {
+	if filepath.Base(os.Args[0]) == "file" {
+		fileMode(os.Args[1:])
+		return
+	}
+	// Only the bare command names are matched, so -- and paths such as
+	// ./file identify the files named after them.
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "scan":
+			scanFiles(os.Args[2:])
+			return
+		case "file":
+			fileMode(os.Args[2:])
+			return
+		case "query":
+			query(os.Args[2:])
+			return
+		case "default":
+			setDefault(os.Args[2:])
+			return
+		case "db":
+			db(os.Args[2:])
+			return
+		}
+	}
 	flag.Usage = usage
 	setup()
 	if standardInput {
 		identify("")
+		closeRecords()
 		os.Exit(0)
 	}
+	if batch {
+		identifyBatch()
+		closeRecords()
+		return
+	}
 	for _, filename := range files {
+		// Opening a FIFO would block, so special files are
+		// identified by their type alone.
+		if info, err := os.Stat(filename); err == nil && !info.Mode().IsRegular() && !info.IsDir() &&
+			!filenameOnly && !treeMagic {
+			mimeType, err = mimemagic.MatchPath(filename, true)
+			report(filename, mimeType, "inode", err)
+			continue
+		}
 		input, err = os.Open(filename)
-		if printError(err) {
+		if err != nil {
+			report(filename, mimemagic.MediaType{}, "", err)
 			continue
 		}
 		identify(filename)
 	}
+	closeRecords()
+	if mismatch {
+		os.Exit(1)
+	}
 }
 
 func usage() {
 	fmt.Fprint(os.Stderr, "Usage: mimemagic [options] <file> ...\n"+
+		"       mimemagic scan [options] <file> ...\n"+
+		"       mimemagic file [options] <file> ...\n"+
+		"       mimemagic query filetype|default <file|mimetype>\n"+
+		"       mimemagic default <application.desktop> <mimetype> ...\n"+
+		"       mimemagic db list|show <mimetype>|ext <.ext>|magic <mimetype>\n"+
 		"Determines the MIME type of the given file(s).\n\n"+
+		"Commands:\n"+
+		"  scan\n"+
+		"    \tFind files embedded at any offset within the given file(s).\n"+
+		"  file\n"+
+		"    \tDetermine the type of the given file(s) with the flags and output\n"+
+		"    \tof file(1).\n"+
+		"  query, default\n"+
+		"    \tQuery the MIME type of a file, or query and set the default\n"+
+		"    \tapplication for a MIME type, as xdg-mime does.\n"+
+		"  db\n"+
+		"    \tInspect the MIME type database.\n\n"+
 		"Options:\n")
 	flag.PrintDefaults()
 	fmt.Fprint(os.Stderr, "\nArguments:\n"+
 		"  file\n"+
 		"    \tThe file(s) to test. '-' to read from stdin. If '-' is set, all other\n"+
-		"    \tinputs will be ignored.\n")
+		"    \tinputs will be ignored. A file named after a command, such as\n"+
+		"    \tfile, must be preceded by -- or given as a path, such as ./file.\n\n"+
+		"Run under the name file, such as through a symbolic link, mimemagic\n"+
+		"behaves as mimemagic file.\n")
 }
 
 func setup() {
@@ -88,11 +204,16 @@ func setup() {
 		flag.Usage()
 		os.Exit(2)
 	}
-	if (treeMagic || xmlNamespace) && (contentOnly || filenameOnly) || (treeMagic && xmlNamespace) {
+	batch = jobs > 1 || recursive || summary || len(include) > 0 || len(exclude) > 0 || len(types) > 0
+	if (treeMagic || xmlNamespace || consistency) && (contentOnly || filenameOnly) ||
+		(treeMagic && xmlNamespace) || (consistency && (treeMagic || xmlNamespace)) ||
+		allTreeMagic && !treeMagic || jobs < 1 ||
+		batch && (contentOnly || filenameOnly || treeMagic || xmlNamespace || consistency) || !validFormat() {
 		fmt.Fprint(os.Stderr, "invalid flag combination\n")
 		flag.Usage()
 		os.Exit(2)
 	}
+	records = newRecordWriter()
 	if preferGlob {
 		preference = mimemagic.Glob
 	}
@@ -111,7 +232,7 @@ func setup() {
 		files = files[:1]
 		return
 	}
-	if len(files) > 1 {
+	if len(files) > 1 || recursive {
 		prependFilename = true
 	}
 }
@@ -130,32 +251,91 @@ func printError(err error) bool {
 }
 
 func identify(filename string) {
+	if consistency {
+		checkConsistency(filename)
+		return
+	}
+	var method string
 	switch {
 	case contentOnly:
 		mimeType, err = mimemagic.MatchReader(input, "", limit)
+		method = "magic"
 	case filenameOnly:
 		mimeType = mimemagic.MatchGlob(filepath.Base(filename))
+		method = "glob"
+	case treeMagic && allTreeMagic:
+		identifyAll(filename)
+		return
 	case treeMagic:
 		mimeType, err = mimemagic.MatchTreeMagic(filename)
+		method = "treemagic"
 	case xmlNamespace:
 		mimeType = mimemagic.MatchXMLReader(input, limit)
+		method = "xml"
 	default:
 		mimeType, err = mimemagic.MatchFile(input, limit, preference)
+		method = detectionMethod(filename, mimeType)
+	}
+	report(filename, mimeType, method, err)
+	input.Close()
+}
+
+func printMediaType(filename string, m mimemagic.MediaType) {
+	// Base names are ambiguous across directories.
+	if !recursive {
+		filename = filepath.Base(filename)
 	}
-	if !printError(err) {
-		if prependFilename {
-			if !humanReadable {
-				fmt.Println(filepath.Base(filename) + ": " + mimeType.MediaType())
-			} else {
-				fmt.Println(filepath.Base(filename) + ": " + mimeType.Comment)
-			}
-		} else {
-			if !humanReadable {
-				fmt.Println(mimeType.MediaType())
-			} else {
-				fmt.Println(mimeType.Comment)
-			}
+	if !humanReadable {
+		printLine(filename, m.MediaType())
+	} else {
+		printLine(filename, m.Comment)
+	}
+}
+
+func closeRecords() {
+	if records != nil {
+		records.close()
+	}
+}
+
+func identifyAll(filename string) {
+	input.Close()
+	mimeTypes, err := mimemagic.MatchTreeMagicAll(filename)
+	if printError(err) {
+		return
+	}
+	if len(mimeTypes) == 0 {
+		// Nothing matched, so fall back the way MatchTreeMagic
+		// does instead of examining the tree again.
+		fallback := "application/octet-stream"
+		if info, err := os.Lstat(filename); err == nil && info.IsDir() {
+			fallback = "inode/directory"
 		}
+		mimeType, _ = mimemagic.Lookup(fallback)
+		mimeTypes = append(mimeTypes, mimeType)
 	}
+	out := make([]string, len(mimeTypes))
+	for i, m := range mimeTypes {
+		out[i] = m.MediaType()
+		if humanReadable {
+			out[i] = m.Comment
+		}
+	}
+	printLine(filepath.Base(filename), strings.Join(out, ", "))
+}
+
+func checkConsistency(filename string) {
+	c, err := mimemagic.CheckConsistencyReader(input, filepath.Base(filename), limit)
 	input.Close()
+	if printError(err) {
+		return
+	}
+	if c.Verdict == mimemagic.Mismatch {
+		mismatch = true
+	}
+	glob, magic := c.Glob.MediaType(), c.Magic.MediaType()
+	if humanReadable {
+		glob, magic = c.Glob.Comment, c.Magic.Comment
+	}
+	printLine(filepath.Base(filename), fmt.Sprintf("%v (name: %s, content: %s)", c.Verdict, glob, magic))
 }
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+func scanUsage(fs *flag.FlagSet) func() {
+	return func() {
+		fmt.Fprint(os.Stderr, "Usage: mimemagic scan [options] <file> ...\n"+
+			"Finds files embedded at any offset within the given file(s), such as disk\n"+
+			"images and firmware blobs. Each line holds the offset of the embedded\n"+
+			"file, prefixed by the offsets of the containers it was found in and its\n"+
+			"name within them, followed by its MIME type.\n\n"+
+			"Options:\n")
+		fs.PrintDefaults()
+		fmt.Fprint(os.Stderr, "\nExamples:\n"+
+			"  $ mimemagic scan -r 1 firmware.bin\n"+
+			"    \t0x200: image/png\n"+
+			"    \t0x1a2f0: application/gzip\n"+
+			"    \t0x1a2f0/vmlinux:0x0: application/x-executable\n")
+	}
+}
+
+func scanFiles(args []string) {
+	var opts mimemagic.ScanOptions
+	var human bool
+	fs := flag.NewFlagSet("scan", flag.ExitOnError)
+	fs.Usage = scanUsage(fs)
+	fs.BoolVar(&human, "i", false,
+		"Output the MIME type in a human readable format.")
+	fs.IntVar(&opts.Depth, "r", 0,
+		"The number of levels of nested gzip streams and zip archives to\n"+
+			"descend into.")
+	fs.IntVar(&opts.MinStrength, "s", 4,
+		"The least number of bytes a magic signature has to compare to be\n"+
+			"considered. Lower values find more, but report more false positives.")
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fmt.Fprint(os.Stderr, "filename not specified\n")
+		fs.Usage()
+		os.Exit(2)
+	}
+	for _, filename := range fs.Args() {
+		f, err := os.Open(filename)
+		if printError(err) {
+			continue
+		}
+		info, err := f.Stat()
+		if printError(err) {
+			f.Close()
+			continue
+		}
+		if fs.NArg() > 1 {
+			fmt.Println(filename + ":")
+		}
+		err = mimemagic.Scan(f, info.Size(), opts, func(r mimemagic.ScanResult) error {
+			out := r.MediaType.MediaType()
+			if human {
+				out = r.MediaType.Comment
+			}
+			fmt.Println(scanPath(&r) + ": " + out)
+			return nil
+		})
+		f.Close()
+		printError(err)
+	}
+}
+
+func scanPath(r *mimemagic.ScanResult) string {
+	s := fmt.Sprintf("%#x", r.Offset)
+	for ; r.Parent != nil; r = r.Parent {
+		s = fmt.Sprintf("%#x/%s:", r.Parent.Offset, r.Name) + s
+	}
+	return s
+}
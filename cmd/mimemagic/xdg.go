@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+// The exit codes of xdg-mime.
+const (
+	xdgSyntaxError   = 1
+	xdgFileNotFound  = 2
+	xdgActionFailed  = 4
+	defaultAppsGroup = "Default Applications"
+)
+
+func xdgUsage() {
+	fmt.Fprint(os.Stderr, "Usage: mimemagic query filetype <file>\n"+
+		"       mimemagic query default <mimetype>\n"+
+		"       mimemagic default <application.desktop> <mimetype> ...\n"+
+		"Queries and sets the MIME type associations of applications the way\n"+
+		"xdg-mime does, reading the mimeapps.list files and desktop entries in\n"+
+		"the XDG config and data directories, such as ~/.config/mimeapps.list\n"+
+		"and /usr/share/applications.\n\n"+
+		"Commands:\n"+
+		"  query filetype\n"+
+		"    \tOutput the MIME type of the file.\n"+
+		"  query default\n"+
+		"    \tOutput the desktop entry of the default application for the MIME\n"+
+		"    \ttype, if any.\n"+
+		"  default\n"+
+		"    \tMake the application the default for the MIME type(s), in\n"+
+		"    \t$XDG_CONFIG_HOME/mimeapps.list.\n")
+}
+
+func xdgFail(code int, format string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, "mimemagic: "+format+"\n", a...)
+	if code == xdgSyntaxError {
+		xdgUsage()
+	}
+	os.Exit(code)
+}
+
+func query(args []string) {
+	if len(args) != 2 {
+		xdgFail(xdgSyntaxError, "query requires a type and an argument")
+	}
+	switch args[0] {
+	case "filetype":
+		m, err := mimemagic.MatchFilePath(args[1])
+		if err != nil {
+			xdgFail(xdgFileNotFound, "cannot open '%s'", args[1])
+		}
+		fmt.Println(m.MediaType())
+	case "default":
+		a, err := mimemagic.LoadAssociations(mimemagic.XDGDirsFromEnv())
+		if err != nil {
+			xdgFail(xdgActionFailed, "%v", err)
+		}
+		if app, ok := a.Default(mediaType(args[1])); ok {
+			fmt.Println(app.ID)
+		}
+	default:
+		xdgFail(xdgSyntaxError, "unknown query type '%s'", args[0])
+	}
+}
+
+func setDefault(args []string) {
+	if len(args) < 2 {
+		xdgFail(xdgSyntaxError, "default requires an application and a MIME type")
+	}
+	app := args[0]
+	if !strings.HasSuffix(app, ".desktop") {
+		xdgFail(xdgSyntaxError, "malformed argument '%s', expecting an application.desktop", app)
+	}
+	path := filepath.Join(mimemagic.XDGDirsFromEnv().ConfigHome, "mimeapps.list")
+	for _, mimeType := range args[1:] {
+		if err := setKey(path, defaultAppsGroup, mimeType, app); err != nil {
+			xdgFail(xdgActionFailed, "%v", err)
+		}
+	}
+}
+
+// mediaType returns the MediaType with the name, which doesn't have
+// to be in the database, as with x-scheme-handler/https.
+func mediaType(name string) mimemagic.MediaType {
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) < 2 {
+		return mimemagic.MediaType{Media: name}
+	}
+	return mimemagic.MediaType{Media: parts[0], Subtype: parts[1]}
+}
+
+// setKey sets the key in the group of the key file to value,
+// creating the file and the group if need be, and keeping the rest
+// of its contents as they are.
+func setKey(path, group, key, value string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var lines []string
+	if len(data) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	}
+	entry := key + "=" + value
+	in, insert := false, -1
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, "[") {
+			if in {
+				break
+			}
+			in = line == "["+group+"]"
+			if in {
+				insert = i + 1
+			}
+			continue
+		}
+		if !in {
+			continue
+		}
+		if j := strings.IndexByte(line, '='); j > -1 && strings.TrimSpace(line[:j]) == key {
+			lines[i], insert = entry, -2
+			break
+		}
+		if line != "" {
+			insert = i + 1
+		}
+	}
+	switch insert {
+	case -2:
+	case -1:
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "["+group+"]", entry)
+	default:
+		lines = append(lines[:insert], append([]string{entry}, lines[insert:]...)...)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644)
+}
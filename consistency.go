@@ -0,0 +1,109 @@
+package mimemagic
+
+import "io"
+
+// Verdict is the outcome of CheckConsistency.
+type Verdict int
+
+const (
+	// Unknown means either the file name or the content
+	// couldn't be identified, so there's nothing to compare.
+	Unknown Verdict = iota
+	// Consistent means the file name and the content agree on
+	// the MIME type, possibly through an alias.
+	Consistent
+	// CompatibleSubclass means one of the MIME types is a
+	// subclass of the other, as with an .epub file which
+	// content identifies as application/zip.
+	CompatibleSubclass
+	// Mismatch means the file name and the content identify
+	// unrelated MIME types, as with an invoice.pdf that's
+	// actually an executable.
+	Mismatch
+)
+
+var verdicts = [...]string{"unknown", "consistent", "compatible-subclass", "mismatch"}
+
+func (v Verdict) String() string {
+	if v < 0 || int(v) >= len(verdicts) {
+		return "invalid verdict"
+	}
+	return verdicts[v]
+}
+
+// Consistency holds the verdict of CheckConsistency along with
+// the MIME types determined by the file name and by the content.
+type Consistency struct {
+	Verdict
+	Glob, Magic MediaType
+}
+
+// CheckConsistency determines the MIME type of the file in a
+// byte slice form using its filename and its content separately,
+// and reports whether they agree. Every glob match is considered,
+// along with the magic matches of the highest priority, so that a
+// weaker signature of the type the file name claims doesn't make a
+// spoofed file consistent. The reported MIME types are the pair
+// that reconciled best, or the top matches in case of a Mismatch.
+// Empty data yields Unknown, since it's consistent with any
+// file name.
+func CheckConsistency(data []byte, filename string) Consistency {
+	v, g, m := checkConsistency(data, filename)
+	return Consistency{v, mediaTypes[g], mediaTypes[m]}
+}
+
+// CheckConsistencyReader is an io.Reader wrapper for
+// CheckConsistency that can be supplied with a limit on the data
+// to read. Negative values of limit will read the file up until
+// the longest magic signature in the database.
+func CheckConsistencyReader(r io.Reader, filename string, limit int) (Consistency, error) {
+	if limit < 0 || limit > magicMaxLen {
+		limit = magicMaxLen
+	}
+	data, err := readData(r, limit)
+	if err != nil {
+		return Consistency{Unknown, mediaTypes[unknownType], mediaTypes[unknownType]}, err
+	}
+	return CheckConsistency(data, filename), nil
+}
+
+func checkConsistency(data []byte, filename string) (Verdict, int, int) {
+	globMatches := matchGlobAll(filename)
+	if len(data) == 0 {
+		return Unknown, globMatches[0], emptyDocument
+	}
+	var magicMatches []int
+	priority := 0
+	for _, m := range magicSignatures {
+		// The signatures are sorted by priority, so the rest
+		// are weaker than the best match.
+		if magicMatches != nil && m.priority < priority {
+			break
+		}
+		if m.match(data) {
+			magicMatches = append(magicMatches, m.mediaType)
+			priority = m.priority
+		}
+	}
+	if magicMatches == nil {
+		if !isTextFile(data) {
+			return Unknown, globMatches[0], unknownType
+		}
+		magicMatches = []int{plainText}
+	}
+	if globMatches[0] == unknownType {
+		return Unknown, unknownType, magicMatches[0]
+	}
+	verdict, glob, magic := Mismatch, globMatches[0], magicMatches[0]
+	for _, m := range magicMatches {
+		for _, g := range globMatches {
+			switch {
+			case mediaTypes[g].Is(mediaTypes[m].MediaType()) && mediaTypes[m].Is(mediaTypes[g].MediaType()):
+				return Consistent, g, m
+			case verdict == Mismatch && (mediaTypes[g].Is(mediaTypes[m].MediaType()) || mediaTypes[m].Is(mediaTypes[g].MediaType())):
+				verdict, glob, magic = CompatibleSubclass, g, m
+			}
+		}
+	}
+	return verdict, glob, magic
+}
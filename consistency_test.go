@@ -0,0 +1,37 @@
+package mimemagic
+
+import "testing"
+
+func TestCheckConsistency(t *testing.T) {
+	tests := []struct {
+		name, filename, data string
+		want                 Verdict
+		wantGlob, wantMagic  string
+	}{
+		{"consistent", "image.png", string(pngHeader), Consistent, "image/png", "image/png"},
+		{"consistent case", "IMAGE.PNG", string(pngHeader), Consistent, "image/png", "image/png"},
+		{"subclass of content", "book.epub", "PK\x03\x04\x14\x00\x00\x00", CompatibleSubclass, "application/epub+zip", "application/zip"},
+		{"subclass of name", "book.zip", epubHeader, CompatibleSubclass, "application/zip", "application/epub+zip"},
+		{"text", "main.c", "int main() { return 0; }\n", CompatibleSubclass, "text/x-csrc", "text/plain"},
+		{"mismatch", "invoice.pdf", elfHeader, Mismatch, "application/pdf", "application/x-executable"},
+		{"weak match of name", "invoice.pdf", "<?php echo 1; ?>\n%PDF-1.4\n", Mismatch, "application/pdf", "application/x-php"},
+		{"unknown name", "invoice", elfHeader, Unknown, "application/octet-stream", "application/x-executable"},
+		{"unknown content", "invoice.pdf", "\x00\x01\x02\x03\xff\xfe", Unknown, "application/pdf", "application/octet-stream"},
+		{"empty", "invoice.pdf", "", Unknown, "application/pdf", "application/x-zerosize"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := CheckConsistency([]byte(test.data), test.filename)
+			if got.Verdict != test.want {
+				t.Errorf("CheckConsistency() = %v, want %v", got.Verdict, test.want)
+			}
+			if got.Glob.MediaType() != test.wantGlob || got.Magic.MediaType() != test.wantMagic {
+				t.Errorf("CheckConsistency() = %v, %v, want %v, %v",
+					got.Glob.MediaType(), got.Magic.MediaType(), test.wantGlob, test.wantMagic)
+			}
+		})
+	}
+	if got := Verdict(-1).String(); got != "invalid verdict" {
+		t.Errorf("Verdict.String() = %v, want %v", got, "invalid verdict")
+	}
+}
@@ -0,0 +1,132 @@
+package mimemagic
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+)
+
+// httpContentTypes maps database MIME types to the strings
+// emitted by net/http's DetectContentType. A detected type
+// that isn't in the table inherits the mapping of its closest
+// superclass, so application/vnd.openxmlformats-officedocument
+// documents are reported as application/zip and C sources as
+// text/plain, just like net/http would.
+//
+//	Database type              net/http
+//	text/html                  text/html; charset=utf-8
+//	application/xml            text/xml; charset=utf-8
+//	text/plain                 text/plain; charset=utf-8
+//	application/x-zerosize     text/plain; charset=utf-8
+//	application/pdf            application/pdf
+//	application/postscript     application/postscript
+//	image/vnd.microsoft.icon   image/x-icon
+//	image/bmp                  image/bmp
+//	image/gif                  image/gif
+//	image/webp                 image/webp
+//	image/png                  image/png
+//	image/jpeg                 image/jpeg
+//	audio/basic                audio/basic
+//	audio/x-aiff               audio/aiff
+//	audio/mpeg                 audio/mpeg
+//	application/ogg            application/ogg
+//	audio/midi                 audio/midi
+//	video/x-msvideo            video/avi
+//	audio/x-wav                audio/wave
+//	video/mp4                  video/mp4
+//	video/webm                 video/webm
+//	font/ttf                   font/ttf
+//	font/otf                   font/otf
+//	font/collection            font/collection
+//	font/woff                  font/woff
+//	font/woff2                 font/woff2
+//	application/gzip           application/x-gzip
+//	application/zip            application/zip
+//	application/vnd.rar        application/x-rar-compressed
+//	application/octet-stream   application/octet-stream
+var httpContentTypes = [...]struct{ mediaType, contentType string }{
+	{"text/html", "text/html; charset=utf-8"},
+	{"application/xml", "text/xml; charset=utf-8"},
+	{"text/plain", "text/plain; charset=utf-8"},
+	{"application/x-zerosize", "text/plain; charset=utf-8"},
+	{"application/pdf", "application/pdf"},
+	{"application/postscript", "application/postscript"},
+	{"image/vnd.microsoft.icon", "image/x-icon"},
+	{"image/bmp", "image/bmp"},
+	{"image/gif", "image/gif"},
+	{"image/webp", "image/webp"},
+	{"image/png", "image/png"},
+	{"image/jpeg", "image/jpeg"},
+	{"audio/basic", "audio/basic"},
+	{"audio/x-aiff", "audio/aiff"},
+	{"audio/mpeg", "audio/mpeg"},
+	{"application/ogg", "application/ogg"},
+	{"audio/midi", "audio/midi"},
+	{"video/x-msvideo", "video/avi"},
+	{"audio/x-wav", "audio/wave"},
+	{"video/mp4", "video/mp4"},
+	{"video/webm", "video/webm"},
+	{"font/ttf", "font/ttf"},
+	{"font/otf", "font/otf"},
+	{"font/collection", "font/collection"},
+	{"font/woff", "font/woff"},
+	{"font/woff2", "font/woff2"},
+	{"application/gzip", "application/x-gzip"},
+	{"application/zip", "application/zip"},
+	{"application/vnd.rar", "application/x-rar-compressed"},
+	{"application/octet-stream", "application/octet-stream"},
+}
+
+var (
+	httpContentTypeIndex     map[int]string
+	httpContentTypeIndexOnce sync.Once
+)
+
+// DetectContentType is a drop-in replacement for net/http's
+// DetectContentType. It always returns a valid MIME type, using
+// the same strings net/http emits for the types it knows about
+// (see httpContentTypes), and the database MIME type otherwise.
+// Data that MatchMagic can't identify is handed over to
+// net/http, so the result is never less specific than the
+// standard library's.
+func DetectContentType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, utf16beBOM):
+		return "text/plain; charset=utf-16be"
+	case bytes.HasPrefix(data, utf16leBOM):
+		return "text/plain; charset=utf-16le"
+	case bytes.HasPrefix(data, utf8BOM):
+		return "text/plain; charset=utf-8"
+	}
+	m := matchMagic(data)
+	if m == unknownType {
+		return http.DetectContentType(data)
+	}
+	return httpContentType(m)
+}
+
+func httpContentType(m int) string {
+	httpContentTypeIndexOnce.Do(func() {
+		httpContentTypeIndex = make(map[int]string, len(httpContentTypes))
+		for _, t := range httpContentTypes {
+			if i := lookup(t.mediaType); i > -1 {
+				httpContentTypeIndex[i] = t.contentType
+			}
+		}
+	})
+	queue, seen := []int{m}, map[int]bool{m: true}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		if s, ok := httpContentTypeIndex[t]; ok {
+			return s
+		}
+		for _, p := range mediaTypes[t].subClassOf {
+			if !seen[p] {
+				seen[p] = true
+				queue = append(queue, p)
+			}
+		}
+	}
+	return mediaTypes[m].MediaType()
+}
@@ -0,0 +1,85 @@
+package mimemagic
+
+import (
+	"net/http"
+	"testing"
+)
+
+var contentTypeTests = []struct {
+	name string
+	data string
+}{
+	{"empty", ""},
+	{"plain text", "Hello, world!\n"},
+	{"html", "<!DOCTYPE HTML><html><body>x</body></html>"},
+	{"html leading whitespace", "  <html><head></head></html>"},
+	{"xml", "<?xml version=\"1.0\"?><root/>"},
+	{"pdf", "%PDF-1.4\n%"},
+	{"postscript", "%!PS-Adobe-3.0\n"},
+	{"utf-16be bom", "\xfe\xffhi"},
+	{"utf-16le bom", "\xff\xfehi"},
+	{"utf-8 bom", "\xef\xbb\xbfhi"},
+	{"ico", "\x00\x00\x01\x00\x01\x00\x10\x10\x00\x00\x01\x00\x20\x00\x68\x04\x00\x00\x16\x00\x00\x00"},
+	{"bmp", "BM\x36\x00\x0c\x00\x00\x00\x00\x00\x36\x00\x00\x00\x28\x00\x00\x00"},
+	{"gif87a", "GIF87a\x01\x00\x01\x00"},
+	{"gif89a", "GIF89a\x01\x00\x01\x00"},
+	{"webp", "RIFF\x00\x00\x00\x00WEBPVP8 "},
+	{"png", "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"},
+	{"jpeg", "\xff\xd8\xff\xe0\x00\x10JFIF\x00"},
+	{"aiff", "FORM\x00\x00\x00\x00AIFFCOMM"},
+	{"mp3", "ID3\x03\x00\x00\x00\x00\x00\x00"},
+	{"ogg", "OggS\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x1evorbis"},
+	{"midi", "MThd\x00\x00\x00\x06\x00\x01"},
+	{"avi", "RIFF\x00\x00\x00\x00AVI LIST"},
+	{"wav", "RIFF\x00\x00\x00\x00WAVEfmt "},
+	{"mp4", "\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom"},
+	{"webm", "\x1a\x45\xdf\xa3\x01\x00\x00\x00\x00\x00\x00\x1f\x42\x86\x81\x01\x42\xf7\x81\x01\x42\xf2\x81\x04\x42\xf3\x81\x08\x42\x82\x84webm"},
+	{"ttf", "\x00\x01\x00\x00\x00\x0e\x00\x80\x00\x03\x00\x60"},
+	{"otf", "OTTO\x00\x0e\x00\x80"},
+	{"ttc", "ttcf\x00\x01\x00\x00"},
+	{"woff", "wOFF\x00\x01\x00\x00"},
+	{"woff2", "wOF2\x00\x01\x00\x00"},
+	{"gzip", "\x1f\x8b\x08\x00\x00\x00\x00\x00"},
+	{"zip", "PK\x03\x04\x14\x00\x00\x00"},
+	{"rar4", "Rar!\x1a\x07\x00\xcf"},
+	{"rar5", "Rar!\x1a\x07\x01\x00"},
+	{"wasm", "\x00asm\x01\x00\x00\x00"},
+	{"binary", "\x00\x01\x02\x03\xff\xfe"},
+	{"c source", "#include <stdio.h>\nint main() {}\n"},
+}
+
+func TestDetectContentType(t *testing.T) {
+	for _, test := range contentTypeTests {
+		t.Run(test.name, func(t *testing.T) {
+			want := http.DetectContentType([]byte(test.data))
+			if got := DetectContentType([]byte(test.data)); got != want {
+				t.Errorf("DetectContentType() = %v, want %v", got, want)
+			}
+		})
+	}
+	tests := []struct {
+		name, data, want string
+	}{
+		{"7z", "7z\xbc\xaf\x27\x1c\x00\x04", "application/x-7z-compressed"},
+		{"svg", "<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>", "text/xml; charset=utf-8"},
+		{"sun audio", ".snd\x00\x00\x00\x18", "audio/basic"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := DetectContentType([]byte(test.data)); got != test.want {
+				t.Errorf("DetectContentType() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func BenchmarkDetectContentType(b *testing.B) {
+	for _, test := range contentTypeTests {
+		data := []byte(test.data)
+		b.Run(test.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				DetectContentType(data)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package mimemagic
+
+import (
+	"sort"
+	"strings"
+)
+
+// GlobPattern is a file name pattern of a MIME type, as in the
+// shared-mime-info database. Patterns can hold a leading or a
+// trailing asterisk, and bracket expressions.
+type GlobPattern struct {
+	Pattern       string
+	Weight        int
+	CaseSensitive bool
+}
+
+// MagicSignature is a magic signature of a MIME type, which
+// matches if any of its rules does. Signatures of higher Priority
+// are checked first.
+type MagicSignature struct {
+	Priority int
+	Rules    []MagicRule
+}
+
+// MagicRule is a rule of a magic signature. It matches if Value,
+// with the data ANDed with Mask if set, is found at Offset, or
+// anywhere up to Range bytes after it, and if there are any Next
+// rules, one of them matches as well.
+type MagicRule struct {
+	Offset, Range int
+	Value, Mask   []byte
+	Next          []MagicRule
+}
+
+// XMLRoot is the namespace and the local name of the root element
+// of the XML documents of a MIME type.
+type XMLRoot struct {
+	NamespaceURI, LocalName string
+}
+
+// TreeMagicSignature is a tree magic signature of an x-content
+// MIME type, which matches if any of its rules does. Signatures
+// of higher Priority are checked first.
+type TreeMagicSignature struct {
+	Priority int
+	Rules    []TreeMagicRule
+}
+
+// TreeMagicRule is a rule of a tree magic signature. It matches
+// if Path exists within the volume and, if set, is of Type
+// "file", "directory" or "link", executable, a non-empty
+// directory, or of MediaType, and if there are any Next rules,
+// one of them matches as well.
+type TreeMagicRule struct {
+	Path, Type, MediaType           string
+	MatchCase, Executable, NonEmpty bool
+	Next                            []TreeMagicRule
+}
+
+// Lookup returns the MIME type in the database with the name, or
+// whose alias it is, and whether there's one.
+func Lookup(name string) (MediaType, bool) {
+	if i := lookup(name); i > -1 {
+		return mediaTypes[i], true
+	}
+	return MediaType{}, false
+}
+
+// MediaTypes returns every MIME type in the database, sorted by
+// name.
+func MediaTypes() []MediaType {
+	types := make([]MediaType, len(mediaTypes))
+	copy(types, mediaTypes)
+	sort.Slice(types, func(i, j int) bool { return types[i].MediaType() < types[j].MediaType() })
+	return types
+}
+
+// Subclasses returns the MIME types that are a direct subclass of
+// m, sorted by name.
+func (m MediaType) Subclasses() []MediaType {
+	i := lookup(m.MediaType())
+	var types []MediaType
+	for _, t := range mediaTypes {
+		for _, p := range t.subClassOf {
+			if p == i {
+				types = append(types, t)
+				break
+			}
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].MediaType() < types[j].MediaType() })
+	return types
+}
+
+// Globs returns the file name patterns of m, by descending weight.
+func (m MediaType) Globs() []GlobPattern {
+	i := lookup(m.MediaType())
+	var patterns []GlobPattern
+	add := func(table map[string][]simpleGlob, caseSensitive bool, pattern func(string) string) {
+		for s, matches := range table {
+			for _, g := range matches {
+				if g.mimeType == i {
+					patterns = append(patterns, GlobPattern{pattern(s), g.weight, caseSensitive})
+				}
+			}
+		}
+	}
+	literal := func(s string) string { return s }
+	suffix := func(s string) string { return "*" + s }
+	prefix := func(s string) string { return s + "*" }
+	add(text, false, literal)
+	add(textCS, true, literal)
+	add(suffixes, false, suffix)
+	add(suffixesCS, true, suffix)
+	add(prefixes, false, prefix)
+	add(prefixesCS, true, prefix)
+	for _, g := range globs {
+		if g.mediaType().mimeType == i {
+			patterns = append(patterns, GlobPattern{globPattern(g), g.mediaType().weight, g.isCaseSensitive()})
+		}
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if patterns[i].Weight != patterns[j].Weight {
+			return patterns[i].Weight > patterns[j].Weight
+		}
+		return patterns[i].Pattern < patterns[j].Pattern
+	})
+	return patterns
+}
+
+// globPattern turns a compiled glob back into its pattern.
+func globPattern(g glob) string {
+	var b strings.Builder
+	var p pattern
+	switch g := g.(type) {
+	case textPattern:
+		p = g.pattern
+	case suffixPattern:
+		b.WriteByte('*')
+		p = g.pattern
+	case prefixPattern:
+		p = g.pattern
+	}
+	for _, m := range p.matchers {
+		switch m := m.(type) {
+		case value:
+			b.WriteString(string(m))
+		case byteMatcher:
+			b.WriteByte('[')
+			writeBracket(&b, m)
+			b.WriteByte(']')
+		}
+	}
+	s := b.String()
+	if _, ok := g.(prefixPattern); ok {
+		s += "*"
+	}
+	return s
+}
+
+func writeBracket(b *strings.Builder, m byteMatcher) {
+	switch m := m.(type) {
+	case list:
+		b.WriteString(string(m))
+	case byteRange:
+		b.WriteByte(m.min)
+		b.WriteByte('-')
+		b.WriteByte(m.max)
+	case any:
+		for _, m := range m {
+			writeBracket(b, m)
+		}
+	}
+}
+
+// Magic returns the magic signatures of m, in the order they're
+// checked in.
+func (m MediaType) Magic() []MagicSignature {
+	i := lookup(m.MediaType())
+	var signatures []MagicSignature
+	for _, s := range magicSignatures {
+		if s.mediaType == i {
+			signatures = append(signatures, MagicSignature{s.priority, magicRules(s.matchers)})
+		}
+	}
+	return signatures
+}
+
+func magicRules(matchers []*magicMatch) []MagicRule {
+	var rules []MagicRule
+	for _, m := range matchers {
+		rules = append(rules, MagicRule{
+			Offset: m.start,
+			Range:  m.length,
+			Value:  m.pattern,
+			Mask:   m.mask,
+			Next:   magicRules(m.next),
+		})
+	}
+	return rules
+}
+
+// XMLRoots returns the root elements of the XML documents of m.
+func (m MediaType) XMLRoots() []XMLRoot {
+	i := lookup(m.MediaType())
+	var roots []XMLRoot
+	for _, n := range namespaces {
+		if n.mediaType == i {
+			roots = append(roots, XMLRoot{n.namespaceURI, n.localName})
+		}
+	}
+	return roots
+}
+
+// TreeMagic returns the tree magic signatures of m.
+func (m MediaType) TreeMagic() []TreeMagicSignature {
+	i := lookup(m.MediaType())
+	var signatures []TreeMagicSignature
+	for _, s := range treeMagicSignatures {
+		if s.mediaType == i {
+			signatures = append(signatures, TreeMagicSignature{s.priority, treeMagicRules(s.matchers)})
+		}
+	}
+	return signatures
+}
+
+var objectTypes = [...]string{anyType: "", fileType: "file", directoryType: "directory", linkType: "link"}
+
+func treeMagicRules(matchers []treeMatch) []TreeMagicRule {
+	var rules []TreeMagicRule
+	for _, t := range matchers {
+		r := TreeMagicRule{
+			Path:       t.path,
+			Type:       objectTypes[t.objectType],
+			MatchCase:  t.matchCase,
+			Executable: t.executable,
+			NonEmpty:   t.nonEmpty,
+			Next:       treeMagicRules(t.next),
+		}
+		if t.mediaType > -1 {
+			r.MediaType = mediaTypes[t.mediaType].MediaType()
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
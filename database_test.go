@@ -0,0 +1,87 @@
+package mimemagic
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func lookupType(t *testing.T, name string) MediaType {
+	m, ok := Lookup(name)
+	if !ok {
+		t.Fatalf("Lookup(%q) found nothing", name)
+	}
+	return m
+}
+
+func TestLookup(t *testing.T) {
+	if m := lookupType(t, "application/x-gzip"); m.MediaType() != "application/gzip" {
+		t.Errorf("Lookup() = %v, want application/gzip", m.MediaType())
+	}
+	if _, ok := Lookup("application/x-nonexistent"); ok {
+		t.Error("Lookup() found a nonexistent MIME type")
+	}
+	types := MediaTypes()
+	if len(types) != len(mediaTypes) {
+		t.Errorf("MediaTypes() returned %d MIME types, want %d", len(types), len(mediaTypes))
+	}
+	if !sort.SliceIsSorted(types, func(i, j int) bool { return types[i].MediaType() < types[j].MediaType() }) {
+		t.Error("MediaTypes() isn't sorted")
+	}
+}
+
+func TestMediaType_Subclasses(t *testing.T) {
+	var names []string
+	for _, m := range lookupType(t, "application/zip").Subclasses() {
+		names = append(names, m.MediaType())
+	}
+	if i := sort.SearchStrings(names, "application/epub+zip"); i == len(names) || names[i] != "application/epub+zip" {
+		t.Errorf("Subclasses() = %v, missing application/epub+zip", names)
+	}
+}
+
+func TestMediaType_Globs(t *testing.T) {
+	tests := []struct {
+		name string
+		want []GlobPattern
+	}{
+		{"image/png", []GlobPattern{{"*.png", 50, false}}},
+		{"video/x-anim", []GlobPattern{{"*.anim[1-9j]", 50, false}}},
+		{"application/x-nonexistent", nil},
+	}
+	for _, tt := range tests {
+		m, _ := Lookup(tt.name)
+		if got := m.Globs(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Globs(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMediaType_Magic(t *testing.T) {
+	signatures := lookupType(t, "image/png").Magic()
+	if len(signatures) == 0 || len(signatures[0].Rules) == 0 {
+		t.Fatalf("Magic() = %v, want a signature", signatures)
+	}
+	if r := signatures[0].Rules[0]; r.Offset != 0 || r.Range != 0 || !bytes.Equal(r.Value, pngHeader[:len(r.Value)]) {
+		t.Errorf("Magic() = %+v, want the PNG header", r)
+	}
+}
+
+func TestMediaType_XMLRoots(t *testing.T) {
+	want := []XMLRoot{{"http://www.w3.org/2005/Atom", "feed"}}
+	if got := lookupType(t, "application/atom+xml").XMLRoots(); !reflect.DeepEqual(got, want) {
+		t.Errorf("XMLRoots() = %v, want %v", got, want)
+	}
+}
+
+func TestMediaType_TreeMagic(t *testing.T) {
+	signatures := lookupType(t, "x-content/video-dvd").TreeMagic()
+	if len(signatures) != 1 || signatures[0].Priority != 50 {
+		t.Fatalf("TreeMagic() = %+v, want a single signature of priority 50", signatures)
+	}
+	want := TreeMagicRule{Path: "VIDEO_TS/VIDEO_TS.IFO", Type: "file"}
+	if got := signatures[0].Rules[0]; !reflect.DeepEqual(got, want) {
+		t.Errorf("TreeMagic() = %+v, want %+v", got, want)
+	}
+}
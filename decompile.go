@@ -0,0 +1,84 @@
+package mimemagic
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// magicElement and matchElement mirror the <magic> and <match>
+// elements of the shared-mime-info format.
+type magicElement struct {
+	Priority int            `xml:"priority,attr"`
+	Match    []matchElement `xml:"match"`
+}
+
+type matchElement struct {
+	Type   string         `xml:"type,attr"`
+	Offset string         `xml:"offset,attr"`
+	Value  string         `xml:"value,attr"`
+	Mask   string         `xml:"mask,attr,omitempty"`
+	Match  []matchElement `xml:"match"`
+}
+
+// MarshalXML encodes the signature as a shared-mime-info <magic>
+// element, which the parser in cmd/parser compiles back into the
+// same signature. Values are given the string type if they're
+// at least half printable, or else the byte, big16 or big32 type
+// if they fit one.
+func (s MagicSignature) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "magic"}
+	return e.EncodeElement(magicElement{s.Priority, matchElements(s.Rules)}, start)
+}
+
+func matchElements(rules []MagicRule) []matchElement {
+	var matches []matchElement
+	for _, r := range rules {
+		m := matchElement{Offset: fmt.Sprint(r.Offset), Match: matchElements(r.Next)}
+		if r.Range > 0 {
+			m.Offset = fmt.Sprintf("%d:%d", r.Offset, r.Offset+r.Range)
+		}
+		switch n := len(r.Value); {
+		case !isPrintable(r.Value) && (n == 1 || n == 2 || n == 4):
+			m.Type = map[int]string{1: "byte", 2: "big16", 4: "big32"}[n]
+			m.Value = fmt.Sprintf("0x%x", r.Value)
+		default:
+			m.Type, m.Value = "string", escapeMagicString(r.Value)
+		}
+		if r.Mask != nil {
+			m.Mask = fmt.Sprintf("0x%x", r.Mask)
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// isPrintable reports whether at least half of b, and at least two
+// bytes of it, are printable ASCII, so that it reads best as a string.
+func isPrintable(b []byte) bool {
+	n := 0
+	for _, c := range b {
+		if c >= ' ' && c <= '~' {
+			n++
+		}
+	}
+	return n >= 2 && 2*n >= len(b)
+}
+
+// escapeMagicString escapes b in the format of the value of a
+// string <match>, with backslashes before special characters and
+// hex escapes for bytes that aren't printable ASCII.
+func escapeMagicString(b []byte) string {
+	var s strings.Builder
+	for _, c := range b {
+		switch {
+		case c == '\\':
+			s.WriteString(`\\`)
+		case c >= ' ' && c <= '~':
+			s.WriteByte(c)
+		default:
+			fmt.Fprintf(&s, `\x%02x`, c)
+		}
+	}
+	return s.String()
+}
@@ -0,0 +1,46 @@
+package mimemagic
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestMagicSignature_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name      string
+		signature MagicSignature
+		want      string
+	}{
+		{
+			"string",
+			MagicSignature{50, []MagicRule{{Offset: 0, Value: []byte("\x89PNG\\")}}},
+			`<magic priority="50"><match type="string" offset="0" value="\x89PNG\\"></match></magic>`,
+		},
+		{
+			"integers",
+			MagicSignature{50, []MagicRule{
+				{Offset: 2, Range: 6, Value: []byte{0xff}},
+				{Offset: 0, Value: []byte{0xca, 0xfe, 0x00, 0x01}, Mask: []byte{0xff, 0xff, 0x00, 0xff}},
+			}},
+			`<magic priority="50"><match type="byte" offset="2:8" value="0xff"></match>` +
+				`<match type="big32" offset="0" value="0xcafe0001" mask="0xffff00ff"></match></magic>`,
+		},
+		{
+			"nested",
+			MagicSignature{50, []MagicRule{{Value: []byte("PK\x03\x04"), Next: []MagicRule{{Offset: 30, Value: []byte("mimetype")}}}}},
+			`<magic priority="50"><match type="string" offset="0" value="PK\x03\x04">` +
+				`<match type="string" offset="30" value="mimetype"></match></match></magic>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xml.Marshal(tt.signature)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
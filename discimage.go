@@ -0,0 +1,225 @@
+package mimemagic
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const sectorLen = 2048
+
+// ErrNotDiscImage is returned when the data handed to
+// NewDiscImageFS, NewISO9660FS or NewUDFFS doesn't hold a
+// file system of the respective format.
+var ErrNotDiscImage = errors.New("not a disc image")
+
+// NewDiscImageFS returns a read-only fs.FS over the optical disc
+// image r, read as UDF if it holds a UDF file system, since
+// video DVDs and Blu-ray discs keep their actual contents there,
+// and as ISO 9660 otherwise. It returns ErrNotDiscImage if r
+// holds neither.
+func NewDiscImageFS(r io.ReaderAt) (fs.FS, error) {
+	fsys, err := NewUDFFS(r)
+	if err != ErrNotDiscImage {
+		return fsys, err
+	}
+	return NewISO9660FS(r)
+}
+
+// discSegment is a run of bytes of a file's content, either at
+// off within the image, or zeroes for unrecorded extents.
+type discSegment struct {
+	off, len int64
+	zero     bool
+}
+
+// discEntry is a file or directory of a disc image, serving as
+// both its fs.FileInfo and its fs.DirEntry. The entries of a
+// directory are read on first use.
+type discEntry struct {
+	name     string
+	mode     fs.FileMode
+	size     int64
+	modTime  time.Time
+	segments []discSegment
+	data     []byte // content embedded in the descriptor itself
+	children []*discEntry
+	loaded   bool
+	// loc is where the format specific reader finds the
+	// entries of a directory, or their descriptor, and tells
+	// directories apart.
+	loc int64
+}
+
+func (e *discEntry) Name() string               { return e.name }
+func (e *discEntry) Size() int64                { return e.size }
+func (e *discEntry) Mode() fs.FileMode          { return e.mode }
+func (e *discEntry) ModTime() time.Time         { return e.modTime }
+func (e *discEntry) IsDir() bool                { return e.mode.IsDir() }
+func (e *discEntry) Sys() interface{}           { return nil }
+func (e *discEntry) Type() fs.FileMode          { return e.mode.Type() }
+func (e *discEntry) Info() (fs.FileInfo, error) { return e, nil }
+
+// discFS is the fs.FS shared by the disc image formats, which
+// only differ in how they read the entries of a directory.
+type discFS struct {
+	r       io.ReaderAt
+	root    *discEntry
+	readDir func(dir *discEntry) ([]*discEntry, error)
+	mu      sync.Mutex
+	// dirs holds the locations of the directories listed so far.
+	dirs map[int64]bool
+}
+
+// children returns the entries of dir, leaving out those whose
+// names aren't valid path elements, and directories listed
+// elsewhere already, so that a corrupt image can't link a
+// directory back to one of its ancestors.
+func (d *discFS) children(dir *discEntry) ([]*discEntry, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !dir.loaded {
+		entries, err := d.readDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		if d.dirs == nil {
+			d.dirs = map[int64]bool{d.root.loc: true}
+		}
+		var children []*discEntry
+		for _, e := range entries {
+			if e.name == "." || strings.IndexByte(e.name, '/') >= 0 || !fs.ValidPath(e.name) {
+				continue
+			}
+			if e.IsDir() {
+				if d.dirs[e.loc] {
+					continue
+				}
+				d.dirs[e.loc] = true
+			}
+			children = append(children, e)
+		}
+		dir.children, dir.loaded = children, true
+	}
+	return dir.children, nil
+}
+
+// Open opens the named file, implementing fs.FS. Symbolic links
+// aren't followed.
+func (d *discFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	e := d.root
+	if name != "." {
+	outer:
+		for _, part := range strings.Split(name, "/") {
+			if !e.IsDir() {
+				return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+			}
+			children, err := d.children(e)
+			if err != nil {
+				return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+			}
+			for _, c := range children {
+				if c.name == part {
+					e = c
+					continue outer
+				}
+			}
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+		}
+	}
+	if e.IsDir() {
+		return &discDir{fsys: d, entry: e, path: name}, nil
+	}
+	readers := make([]io.Reader, 0, len(e.segments)+1)
+	if e.data != nil {
+		readers = append(readers, strings.NewReader(string(e.data)))
+	}
+	for _, s := range e.segments {
+		if s.zero {
+			readers = append(readers, io.LimitReader(zeroReader{}, s.len))
+			continue
+		}
+		readers = append(readers, io.NewSectionReader(d.r, s.off, s.len))
+	}
+	return &discFile{entry: e, r: io.LimitReader(io.MultiReader(readers...), e.size)}, nil
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+type discFile struct {
+	entry *discEntry
+	r     io.Reader
+}
+
+func (f *discFile) Stat() (fs.FileInfo, error) { return f.entry, nil }
+func (f *discFile) Read(p []byte) (int, error) { return f.r.Read(p) }
+func (f *discFile) Close() error               { return nil }
+
+type discDir struct {
+	fsys  *discFS
+	entry *discEntry
+	path  string
+	off   int
+}
+
+func (d *discDir) Stat() (fs.FileInfo, error) { return d.entry, nil }
+func (d *discDir) Close() error               { return nil }
+
+func (d *discDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.path, Err: syscall.EISDIR}
+}
+
+// ReadDir reads the entries of the directory, implementing
+// fs.ReadDirFile.
+func (d *discDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	children, err := d.fsys.children(d.entry)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: d.path, Err: err}
+	}
+	children = children[d.off:]
+	if n > 0 && len(children) == 0 {
+		return nil, io.EOF
+	}
+	if n > 0 && n < len(children) {
+		children = children[:n]
+	}
+	d.off += len(children)
+	entries := make([]fs.DirEntry, len(children))
+	for i, c := range children {
+		entries[i] = c
+	}
+	return entries, nil
+}
+
+// readSector reads the n-th 2048 byte sector of r.
+func readSector(r io.ReaderAt, n int64) ([]byte, error) {
+	return readAt(r, n*sectorLen, sectorLen)
+}
+
+// readAt reads exactly length bytes at off, treating a short
+// read as a corrupt image.
+func readAt(r io.ReaderAt, off, length int64) ([]byte, error) {
+	b := make([]byte, length)
+	n, err := r.ReadAt(b, off)
+	if n == len(b) {
+		return b, nil
+	}
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return nil, err
+}
@@ -0,0 +1,621 @@
+package mimemagic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"unicode/utf16"
+)
+
+// discTree lists the directories of files, with the entries of
+// each sorted by name.
+func discTree(files fstest.MapFS) map[string][]string {
+	tree := map[string][]string{".": nil}
+	for name := range files {
+		for p := name; p != "."; p = path.Dir(p) {
+			dir := path.Dir(p)
+			if _, ok := tree[p]; !ok && (p != name || files[p].Mode.IsDir()) {
+				tree[p] = nil
+			}
+			found := false
+			for _, c := range tree[dir] {
+				found = found || c == path.Base(p)
+			}
+			if !found {
+				tree[dir] = append(tree[dir], path.Base(p))
+			}
+		}
+	}
+	for _, c := range tree {
+		sort.Strings(c)
+	}
+	return tree
+}
+
+// isoImage builds an ISO 9660 image holding files, with a Joliet
+// tree and Rock Ridge extensions if requested.
+func isoImage(files fstest.MapFS, joliet, rockRidge bool) []byte {
+	img := make([]byte, 20*sectorLen)
+	alloc := func(data []byte) int {
+		if len(data) == 0 {
+			return 0
+		}
+		lba := len(img) / sectorLen
+		img = append(img, data...)
+		img = append(img, make([]byte, (sectorLen-len(data)%sectorLen)%sectorLen)...)
+		return lba
+	}
+	extents := make(map[string]int)
+	for name, f := range files {
+		if !f.Mode.IsDir() && f.Mode&fs.ModeSymlink == 0 {
+			extents[name] = alloc(f.Data)
+		}
+	}
+	tree := discTree(files)
+	record := func(lba, size int, dir bool, name, sua []byte) []byte {
+		rec := make([]byte, 33, 256)
+		rec = append(rec, name...)
+		if len(name)%2 == 0 {
+			rec = append(rec, 0)
+		}
+		rec = append(rec, sua...)
+		if len(rec)%2 != 0 {
+			rec = append(rec, 0)
+		}
+		rec[0] = byte(len(rec))
+		binary.LittleEndian.PutUint32(rec[2:], uint32(lba))
+		binary.BigEndian.PutUint32(rec[6:], uint32(lba))
+		binary.LittleEndian.PutUint32(rec[10:], uint32(size))
+		binary.BigEndian.PutUint32(rec[14:], uint32(size))
+		copy(rec[18:], []byte{120, 1, 2, 3, 4, 5, 0})
+		if dir {
+			rec[25] = 2
+		}
+		rec[28], rec[31], rec[32] = 1, 1, byte(len(name))
+		return rec
+	}
+	rrEntries := func(name string, mode fs.FileMode) []byte {
+		px := make([]byte, 36)
+		copy(px, "PX")
+		px[2], px[3] = 36, 1
+		m := uint32(mode.Perm()) | 0100000
+		switch {
+		case mode.IsDir():
+			m = uint32(mode.Perm()) | 0040000
+		case mode&fs.ModeSymlink != 0:
+			m = 0120777
+		}
+		binary.LittleEndian.PutUint32(px[4:], m)
+		binary.BigEndian.PutUint32(px[8:], m)
+		nm := append([]byte{'N', 'M', byte(5 + len(name)), 1, 0}, name...)
+		sua := append(px, nm...)
+		if mode&fs.ModeSymlink != 0 {
+			sua = append(sua, 'S', 'L', 10, 1, 0, 0, 3, 'f', 'o', 'o')
+		}
+		return sua
+	}
+	var writeDir func(dir string, joliet bool) int
+	writeDir = func(dir string, joliet bool) int {
+		var data []byte
+		var dot []byte
+		if rockRidge && !joliet && dir == "." {
+			dot = []byte{'S', 'P', 7, 1, 0xbe, 0xef, 0}
+		}
+		data = append(data, record(0, sectorLen, true, []byte{0}, dot)...)
+		data = append(data, record(0, sectorLen, true, []byte{1}, nil)...)
+		for _, c := range tree[dir] {
+			p := path.Join(dir, c)
+			f, isFile := files[p]
+			_, isDir := tree[p]
+			var name []byte
+			switch {
+			case joliet:
+				for _, u := range utf16.Encode([]rune(c)) {
+					name = append(name, byte(u>>8), byte(u))
+				}
+				if !isDir {
+					name = append(name, 0, ';', 0, '1')
+				}
+			case isDir:
+				name = []byte(strings.ToUpper(c))
+			case strings.Contains(c, "."):
+				name = []byte(strings.ToUpper(c) + ";1")
+			default:
+				name = []byte(strings.ToUpper(c) + ".;1")
+			}
+			var sua []byte
+			if rockRidge && !joliet {
+				mode := fs.ModeDir | 0755
+				if isFile {
+					mode = f.Mode
+				}
+				sua = rrEntries(c, mode)
+			}
+			if isDir {
+				data = append(data, record(writeDir(p, joliet), sectorLen, true, name, sua)...)
+			} else {
+				data = append(data, record(extents[p], len(f.Data), false, name, sua)...)
+			}
+		}
+		return alloc(data)
+	}
+	root := writeDir(".", false)
+	var jolietRoot int
+	if joliet {
+		jolietRoot = writeDir(".", true)
+	}
+	pvd := img[16*sectorLen : 17*sectorLen]
+	pvd[0], pvd[6] = 1, 1
+	copy(pvd[1:], isoIdentifier)
+	binary.LittleEndian.PutUint16(pvd[128:], sectorLen)
+	binary.BigEndian.PutUint16(pvd[130:], sectorLen)
+	copy(pvd[156:], record(root, sectorLen, true, []byte{0}, nil))
+	term := img[17*sectorLen : 18*sectorLen]
+	if joliet {
+		svd := img[17*sectorLen : 18*sectorLen]
+		svd[0], svd[6] = 2, 1
+		copy(svd[1:], isoIdentifier)
+		copy(svd[88:], "%/E")
+		binary.LittleEndian.PutUint16(svd[128:], sectorLen)
+		copy(svd[156:], record(jolietRoot, sectorLen, true, []byte{0}, nil))
+		term = img[18*sectorLen : 19*sectorLen]
+	}
+	term[0], term[6] = 255, 1
+	copy(term[1:], isoIdentifier)
+	return img
+}
+
+// udfBuilder builds a UDF image holding files, optionally with
+// the file entries and directories in a metadata partition, as
+// on Blu-ray discs.
+type udfBuilder struct {
+	files      fstest.MapFS
+	tree       map[string][]string
+	meta       bool
+	blocks     [][]byte
+	metaBlocks int
+}
+
+const (
+	udfPartitionStart = 300
+	udfMetaStart      = 1
+	udfMetaLen        = 64
+)
+
+func udfImage(files fstest.MapFS, meta bool) []byte {
+	b := &udfBuilder{files: files, tree: discTree(files), meta: meta}
+	if meta {
+		b.blocks = make([][]byte, udfMetaStart+udfMetaLen)
+	}
+	rootRef, rootLBN := b.writeDir(".")
+	fsd := make([]byte, sectorLen)
+	putLongAD(fsd[400:], sectorLen, rootLBN, rootRef)
+	fsdRef, fsdLBN := b.desc(fsd, udfFileSet)
+
+	img := make([]byte, udfPartitionStart*sectorLen)
+	for i, id := range []string{"BEA01", "NSR02", "TEA01"} {
+		copy(img[(16+i)*sectorLen+1:], id)
+		img[(16+i)*sectorLen+6] = 1
+	}
+	avdp := img[256*sectorLen:]
+	binary.LittleEndian.PutUint32(avdp[16:], 3*sectorLen)
+	binary.LittleEndian.PutUint32(avdp[20:], 32)
+	udfSetTag(avdp, udfAnchor, 256)
+	pd := img[32*sectorLen:]
+	binary.LittleEndian.PutUint32(pd[188:], udfPartitionStart)
+	binary.LittleEndian.PutUint32(pd[192:], uint32(len(b.blocks)))
+	udfSetTag(pd, udfPartition, 32)
+	lvd := img[33*sectorLen:]
+	binary.LittleEndian.PutUint32(lvd[212:], sectorLen)
+	putLongAD(lvd[248:], sectorLen, fsdLBN, fsdRef)
+	maps := []byte{1, 6, 1, 0, 0, 0}
+	if meta {
+		m := make([]byte, 64)
+		m[0], m[1] = 2, 64
+		copy(m[5:], udfMetadataPartition)
+		binary.LittleEndian.PutUint16(m[36:], 1)
+		maps = append(maps, m...)
+		fe := udfFileEntryDesc(250, 0, udfMetaLen*sectorLen, 0,
+			putShortAD(nil, udfMetaLen*sectorLen, udfMetaStart))
+		udfSetTag(fe, udfFileEntry, 0)
+		b.blocks[0] = fe
+	}
+	binary.LittleEndian.PutUint32(lvd[264:], uint32(len(maps)))
+	binary.LittleEndian.PutUint32(lvd[268:], uint32(len(maps)/6))
+	if meta {
+		binary.LittleEndian.PutUint32(lvd[268:], 2)
+	}
+	copy(lvd[440:], maps)
+	udfSetTag(lvd, udfLogicalVolume, 33)
+	udfSetTag(img[34*sectorLen:], udfTerminating, 34)
+	for _, block := range b.blocks {
+		img = append(img, block...)
+		img = append(img, make([]byte, sectorLen-len(block))...)
+	}
+	return img
+}
+
+func udfSetTag(b []byte, id uint16, loc uint32) {
+	binary.LittleEndian.PutUint16(b, id)
+	binary.LittleEndian.PutUint16(b[2:], 2)
+	binary.LittleEndian.PutUint32(b[12:], loc)
+	var sum byte
+	for i := 0; i < 16; i++ {
+		if i != 4 {
+			sum += b[i]
+		}
+	}
+	b[4] = sum
+}
+
+func putLongAD(b []byte, length, lbn uint32, ref uint16) []byte {
+	if b == nil {
+		b = make([]byte, 16)
+	}
+	binary.LittleEndian.PutUint32(b, length)
+	binary.LittleEndian.PutUint32(b[4:], lbn)
+	binary.LittleEndian.PutUint16(b[8:], ref)
+	return b
+}
+
+func putShortAD(b []byte, length, lbn uint32) []byte {
+	ad := make([]byte, 8)
+	binary.LittleEndian.PutUint32(ad, length)
+	binary.LittleEndian.PutUint32(ad[4:], lbn)
+	return append(b, ad...)
+}
+
+func udfFileEntryDesc(fileType byte, mode fs.FileMode, size uint64, allocType uint16, ads []byte) []byte {
+	fe := make([]byte, 176, sectorLen)
+	binary.LittleEndian.PutUint16(fe[20:], 4)
+	binary.LittleEndian.PutUint16(fe[24:], 1)
+	fe[27] = fileType
+	binary.LittleEndian.PutUint16(fe[34:], allocType)
+	var perm uint32
+	for i := uint(0); i < 3; i++ {
+		perm |= uint32(mode>>(3*i)&7) << (5 * i)
+	}
+	binary.LittleEndian.PutUint32(fe[44:], perm)
+	binary.LittleEndian.PutUint16(fe[48:], 1)
+	binary.LittleEndian.PutUint64(fe[56:], size)
+	binary.LittleEndian.PutUint16(fe[84:], 1<<12)
+	binary.LittleEndian.PutUint16(fe[86:], 2020)
+	fe[88], fe[89] = 1, 2
+	binary.LittleEndian.PutUint32(fe[172:], uint32(len(ads)))
+	return append(fe, ads...)
+}
+
+// phys allocates data in the physical partition.
+func (b *udfBuilder) phys(data []byte) uint32 {
+	lbn := uint32(len(b.blocks))
+	for len(data) > sectorLen {
+		b.blocks = append(b.blocks, data[:sectorLen])
+		data = data[sectorLen:]
+	}
+	b.blocks = append(b.blocks, data)
+	return lbn
+}
+
+// desc allocates the descriptor or directory data in the
+// partition holding metadata, tagging it if id isn't 0.
+func (b *udfBuilder) desc(data []byte, id uint16) (uint16, uint32) {
+	if !b.meta {
+		lbn := uint32(len(b.blocks))
+		if id != 0 {
+			udfSetTag(data, id, lbn)
+		}
+		return 0, b.phys(data)
+	}
+	lbn := uint32(b.metaBlocks)
+	if id != 0 {
+		udfSetTag(data, id, lbn)
+	}
+	for len(data) > 0 {
+		n := min(len(data), sectorLen)
+		b.blocks[udfMetaStart+b.metaBlocks] = data[:n]
+		data = data[n:]
+		b.metaBlocks++
+	}
+	return 1, lbn
+}
+
+func (b *udfBuilder) writeDir(dir string) (uint16, uint32) {
+	fid := func(chars byte, name string, ref uint16, lbn uint32) []byte {
+		f := make([]byte, 38, 64)
+		binary.LittleEndian.PutUint16(f[16:], 1)
+		f[18] = chars
+		putLongAD(f[20:], sectorLen, lbn, ref)
+		if name != "" {
+			f = append(f, 8)
+			f = append(f, name...)
+			f[19] = byte(len(name) + 1)
+		}
+		f = append(f, make([]byte, (4-len(f)%4)%4)...)
+		udfSetTag(f, udfFileIdentifier, 0)
+		return f
+	}
+	data := fid(8, "", 0, 0)
+	for _, c := range b.tree[dir] {
+		p := path.Join(dir, c)
+		if _, ok := b.tree[p]; ok {
+			ref, lbn := b.writeDir(p)
+			data = append(data, fid(2, c, ref, lbn)...)
+			continue
+		}
+		f := b.files[p]
+		var ads []byte
+		if len(f.Data) > 0 {
+			ads = putLongAD(nil, uint32(len(f.Data)), b.phys(f.Data), 0)
+		}
+		fileType := byte(5)
+		if f.Mode&fs.ModeSymlink != 0 {
+			fileType = 12
+		}
+		ref, lbn := b.desc(udfFileEntryDesc(fileType, f.Mode, uint64(len(f.Data)), 1, ads), udfFileEntry)
+		data = append(data, fid(0, c, ref, lbn)...)
+	}
+	_, lbn := b.desc(data, 0)
+	mode := fs.FileMode(0755)
+	if f, ok := b.files[dir]; ok {
+		mode = f.Mode
+	}
+	return b.desc(udfFileEntryDesc(4, mode, uint64(len(data)), 0, putShortAD(nil, uint32(len(data)), lbn)), udfFileEntry)
+}
+
+var discFiles = fstest.MapFS{
+	"VIDEO_TS/VIDEO_TS.IFO":  {Data: []byte("DVDVIDEO-VMG"), Mode: 0644},
+	"VIDEO_TS/VTS_01_1.VOB":  {Data: bytes.Repeat([]byte{0, 0, 1, 0xba}, 1024), Mode: 0644},
+	"autorun":                {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+	"Documents/readme.txt":   {Data: []byte("Long file names\n"), Mode: 0644},
+	"Documents/empty":        {Mode: 0644},
+	"Documents/link":         {Mode: fs.ModeSymlink | 0777},
+	"deep/a/b/c/d/e/f/g/h/i": {Data: []byte("deep"), Mode: 0644},
+}
+
+func TestNewDiscImageFS(t *testing.T) {
+	tests := []struct {
+		name  string
+		image []byte
+		want  []string
+	}{
+		{"iso9660", isoImage(discFiles, false, false), []string{
+			"VIDEO_TS/VIDEO_TS.IFO", "VIDEO_TS/VTS_01_1.VOB", "AUTORUN", "DOCUMENTS/README.TXT",
+			"DOCUMENTS/EMPTY", "DEEP/A/B/C/D/E/F/G/H/I",
+		}},
+		{"joliet", isoImage(discFiles, true, false), []string{
+			"VIDEO_TS/VIDEO_TS.IFO", "autorun", "Documents/readme.txt", "Documents/empty",
+		}},
+		{"rock ridge", isoImage(discFiles, true, true), []string{
+			"VIDEO_TS/VIDEO_TS.IFO", "autorun", "Documents/readme.txt", "Documents/link",
+		}},
+		{"udf", udfImage(discFiles, false), []string{
+			"VIDEO_TS/VIDEO_TS.IFO", "VIDEO_TS/VTS_01_1.VOB", "autorun", "Documents/readme.txt",
+			"Documents/link", "deep/a/b/c/d/e/f/g/h/i",
+		}},
+		{"udf metadata partition", udfImage(discFiles, true), []string{
+			"VIDEO_TS/VIDEO_TS.IFO", "autorun", "Documents/readme.txt", "Documents/empty",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsys, err := NewDiscImageFS(bytes.NewReader(test.image))
+			if err != nil {
+				t.Fatalf("NewDiscImageFS() error = %v", err)
+			}
+			if err := fstest.TestFS(fsys, test.want...); err != nil {
+				t.Error(err)
+			}
+			for _, name := range test.want {
+				data, err := fs.ReadFile(fsys, name)
+				if err != nil {
+					t.Errorf("ReadFile(%s) error = %v", name, err)
+					continue
+				}
+				for want, f := range discFiles {
+					if strings.EqualFold(want, name) && f.Mode.IsRegular() && !bytes.Equal(data, f.Data) {
+						t.Errorf("ReadFile(%s) = %q, want %q", name, data, f.Data)
+					}
+				}
+			}
+		})
+	}
+	t.Run("modes", func(t *testing.T) {
+		for _, image := range [][]byte{isoImage(discFiles, false, true), udfImage(discFiles, false)} {
+			fsys, err := NewDiscImageFS(bytes.NewReader(image))
+			if err != nil {
+				t.Fatalf("NewDiscImageFS() error = %v", err)
+			}
+			for _, name := range []string{"autorun", "Documents/readme.txt", "Documents/link"} {
+				info, err := fs.Stat(fsys, name)
+				if err != nil {
+					t.Errorf("Stat(%s) error = %v", name, err)
+					continue
+				}
+				if info.Mode() != discFiles[name].Mode {
+					t.Errorf("Stat(%s).Mode() = %v, want %v", name, info.Mode(), discFiles[name].Mode)
+				}
+			}
+		}
+	})
+	t.Run("not an image", func(t *testing.T) {
+		for _, data := range [][]byte{nil, []byte("hello"), make([]byte, 300*sectorLen)} {
+			if _, err := NewDiscImageFS(bytes.NewReader(data)); err != ErrNotDiscImage {
+				t.Errorf("NewDiscImageFS() error = %v, want %v", err, ErrNotDiscImage)
+			}
+		}
+	})
+}
+
+// walkDisc reads every file of the disc image, skipping over the
+// errors a corrupt one yields.
+func walkDisc(image []byte) {
+	fsys, err := NewDiscImageFS(bytes.NewReader(image))
+	if err != nil {
+		return
+	}
+	fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			fs.ReadFile(fsys, name)
+		}
+		return nil
+	})
+}
+
+func TestNewDiscImageFSCorrupt(t *testing.T) {
+	iso := isoImage(discFiles, false, true)
+	root := int(binary.LittleEndian.Uint32(iso[16*sectorLen+158:])) * sectorLen
+	first := root + int(iso[root])
+	first += int(iso[first])
+	t.Run("system use area", func(t *testing.T) {
+		image := append([]byte(nil), iso...)
+		// An even length name filling the record, leaving no
+		// room for the padding byte.
+		image[first], image[first+32] = 35, 2
+		walkDisc(image)
+	})
+	t.Run("directory records", func(t *testing.T) {
+		image := append([]byte(nil), iso...)
+		for i := root; i < root+sectorLen && iso[i] != 0; i++ {
+			for _, c := range []byte{0, 1, 34, 0xff} {
+				image[i] = c
+				walkDisc(image)
+			}
+			image[i] = iso[i]
+		}
+	})
+	t.Run("directory cycle", func(t *testing.T) {
+		image := append([]byte(nil), iso...)
+		for off := first; iso[off] != 0; off += int(iso[off]) {
+			if iso[off+25]&2 != 0 {
+				binary.LittleEndian.PutUint32(image[off+2:], uint32(root/sectorLen))
+			}
+		}
+		fsys, err := NewDiscImageFS(bytes.NewReader(image))
+		if err != nil {
+			t.Fatalf("NewDiscImageFS() error = %v", err)
+		}
+		if err := fstest.TestFS(fsys, "autorun"); err != nil {
+			t.Error(err)
+		}
+		if _, err := fs.Stat(fsys, "Documents"); err == nil {
+			t.Errorf("Stat(Documents) of a directory linking back to the root error = nil")
+		}
+	})
+	t.Run("udf sizes", func(t *testing.T) {
+		for _, meta := range []bool{false, true} {
+			udf := udfImage(discFiles, meta)
+			for off := udfPartitionStart * sectorLen; off < len(udf); off += sectorLen {
+				if id, ok := udfTag(udf[off:]); !ok || id != udfFileEntry {
+					continue
+				}
+				for _, size := range []uint64{1 << 63, 1 << 40} {
+					for _, allocType := range []uint16{1, 3} {
+						image := append([]byte(nil), udf...)
+						binary.LittleEndian.PutUint64(image[off+56:], size)
+						binary.LittleEndian.PutUint16(image[off+34:], allocType)
+						walkDisc(image)
+					}
+				}
+			}
+		}
+	})
+	t.Run("names and cycles", func(t *testing.T) {
+		fsys := &discFS{
+			root: &discEntry{name: ".", mode: fs.ModeDir | 0555},
+			readDir: func(dir *discEntry) ([]*discEntry, error) {
+				return []*discEntry{
+					{name: ""},
+					{name: "."},
+					{name: ".."},
+					{name: "a/b"},
+					{name: "file", mode: 0444},
+					{name: "parent", mode: fs.ModeDir | 0555, loc: dir.loc},
+					{name: "sub", mode: fs.ModeDir | 0555, loc: 1},
+				}, nil
+			},
+		}
+		if err := fstest.TestFS(fsys, "file", "sub/file"); err != nil {
+			t.Error(err)
+		}
+		for _, name := range []string{"parent", "sub/sub", "sub/parent"} {
+			if _, err := fs.Stat(fsys, name); err == nil {
+				t.Errorf("Stat(%s) error = nil", name)
+			}
+		}
+	})
+}
+
+func TestMatchTreeMagicDiscImage(t *testing.T) {
+	bluray := fstest.MapFS{
+		"BDMV/index.bdmv":        {Data: []byte("INDX0200"), Mode: 0644},
+		"BDMV/STREAM/00000.m2ts": {Data: []byte{0x47}, Mode: 0644},
+	}
+	fsys := fstest.MapFS{
+		"dvd.iso":       {Data: isoImage(discFiles, true, false)},
+		"dvd-rr.iso":    {Data: isoImage(discFiles, false, true)},
+		"dvd-udf.img":   {Data: udfImage(discFiles, false)},
+		"bluray.iso":    {Data: udfImage(bluray, true)},
+		"plain/bd.iso":  {Data: []byte("not a disc image")},
+		"plain/autorun": {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+	}
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"dvd.iso", []string{"x-content/video-dvd", "x-content/unix-software"}},
+		{"dvd-rr.iso", []string{"x-content/video-dvd", "x-content/unix-software"}},
+		{"dvd-udf.img", []string{"x-content/video-dvd", "x-content/unix-software"}},
+		{"bluray.iso", []string{"x-content/video-bluray"}},
+		{"plain/bd.iso", []string{"x-content/unix-software"}},
+	}
+	dir, err := ioutil.TempDir("", "discimage")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	for name, f := range fsys {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(p), 0755)
+		if err := ioutil.WriteFile(p, f.Data, f.Mode|0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := MatchTreeMagicFS(fsys, test.name)
+			if err != nil {
+				t.Fatalf("MatchTreeMagicFS() error = %v", err)
+			}
+			if got.MediaType() != test.want[0] {
+				t.Errorf("MatchTreeMagicFS() = %v, want %v", got.MediaType(), test.want[0])
+			}
+			got, err = MatchTreeMagic(filepath.Join(dir, filepath.FromSlash(test.name)))
+			if err != nil {
+				t.Fatalf("MatchTreeMagic() error = %v", err)
+			}
+			if got.MediaType() != test.want[0] {
+				t.Errorf("MatchTreeMagic() = %v, want %v", got.MediaType(), test.want[0])
+			}
+			all, err := MatchTreeMagicAllFS(fsys, test.name)
+			if err != nil {
+				t.Fatalf("MatchTreeMagicAllFS() error = %v", err)
+			}
+			if len(all) != len(test.want) {
+				t.Fatalf("MatchTreeMagicAllFS() = %v, want %v", all, test.want)
+			}
+			for i := range all {
+				if all[i].MediaType() != test.want[i] {
+					t.Errorf("MatchTreeMagicAllFS()[%d] = %v, want %v", i, all[i].MediaType(), test.want[i])
+				}
+			}
+		})
+	}
+}
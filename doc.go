@@ -7,12 +7,27 @@ To generate your own database simply remove the leading space, point to the
 directory with freedesktop.org package files (freedesktop.org.xml, if it
 exists, is always processed first and Override.xml is always processed last),
 and run go generate:
-  go:generate go run github.com/zRedShift/mimemagic/v2/cmd/parser /usr/share/mime/packages
+
+	go:generate go run github.com/zRedShift/mimemagic/v2/cmd/parser /usr/share/mime/packages
 
 To use the default freedesktop.org.xml file provided in this package:
-  go:generate go run github.com/zRedShift/mimemagic/v2/cmd/parser cmd/parser
 
-globs.go is generated unformatted so it's a good idea to run this for your OCD
-  go:generate go fmt globs.go
+	go:generate go run github.com/zRedShift/mimemagic/v2/cmd/parser cmd/parser
+
+The generated files are formatted and reproducible, headed by the checksum of
+the package files, so -check can verify in CI that they're up to date:
+
+	go run github.com/zRedShift/mimemagic/v2/cmd/parser -check cmd/parser
+
+To keep the database in a package of your own instead, generate it with
+-package and -o, and switch the matching engine over to it with Register:
+
+	go:generate go run github.com/zRedShift/mimemagic/v2/cmd/parser -package ourmime -o internal/ourmime /usr/share/mime/packages
+
+	func init() {
+		if err := mimemagic.Register(ourmime.Tables); err != nil {
+			panic(err)
+		}
+	}
 */
 package mimemagic
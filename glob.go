@@ -140,7 +140,7 @@ func matchGlobAll(filename string) []int {
 	if globResults == nil {
 		return []int{unknownType}
 	}
-	sort.Slice(globResults, func(i, j int) bool { return globResults[i].weight > globResults[j].weight })
+	sort.SliceStable(globResults, func(i, j int) bool { return globResults[i].weight > globResults[j].weight })
 	results := make([]int, len(globResults))
 	for i := range globResults {
 		results[i] = globResults[i].mimeType
@@ -0,0 +1,159 @@
+package mimemagic
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"path"
+)
+
+const sniffLen = 512
+
+var errHijackNotSupported = errors.New("mimemagic: underlying ResponseWriter doesn't implement http.Hijacker")
+
+// SniffHandler wraps h so that responses which don't set a
+// Content-Type header get one determined by sniffing the first
+// 512 bytes of the body, along with an X-Content-Type-Options:
+// nosniff header, unless the handler set either one itself by
+// the time the body is flushed. If useFilename is set, the
+// base name of the request path is used for glob matching as
+// well, otherwise only the content is examined.
+// Setting the Content-Type header to nil, as with net/http,
+// disables sniffing for that response.
+func SniffHandler(h http.Handler, useFilename bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := &sniffWriter{ResponseWriter: w}
+		if useFilename {
+			if base := path.Base(r.URL.Path); base != "/" && base != "." {
+				sw.filename = base
+			}
+		}
+		// The buffered body is only sent if h returns normally,
+		// so that a panic aborts the response as with net/http.
+		h.ServeHTTP(sw, r)
+		sw.commit()
+	})
+}
+
+type sniffWriter struct {
+	http.ResponseWriter
+	filename            string
+	buf                 []byte
+	status              int
+	committed, hijacked bool
+}
+
+func (w *sniffWriter) WriteHeader(code int) {
+	if w.committed || w.status != 0 {
+		return
+	}
+	// Informational headers go out straight away and
+	// don't count as the final status code.
+	if code >= 100 && code <= 199 && code != http.StatusSwitchingProtocols {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	w.status = code
+}
+
+func (w *sniffWriter) Write(p []byte) (int, error) {
+	if w.committed {
+		return w.ResponseWriter.Write(p)
+	}
+	if !bodyAllowed(w.status) {
+		return 0, http.ErrBodyNotAllowed
+	}
+	if len(w.buf)+len(p) < sniffLen {
+		w.buf = append(w.buf, p...)
+		return len(p), nil
+	}
+	n := sniffLen - len(w.buf)
+	w.buf = append(w.buf, p[:n]...)
+	if err := w.flushBuffer(); err != nil {
+		return 0, err
+	}
+	m, err := w.ResponseWriter.Write(p[n:])
+	return n + m, err
+}
+
+// Flush sends any buffered data to the client, sniffing
+// whatever has been written so far, and flushes the
+// underlying ResponseWriter if it implements http.Flusher.
+func (w *sniffWriter) Flush() {
+	if w.hijacked {
+		return
+	}
+	if !w.committed && w.flushBuffer() != nil {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack passes through to the underlying ResponseWriter,
+// discarding any buffered data.
+func (w *sniffWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errHijackNotSupported
+	}
+	conn, rw, err := h.Hijack()
+	if err == nil {
+		w.hijacked, w.committed, w.buf = true, true, nil
+	}
+	return conn, rw, err
+}
+
+// Unwrap returns the underlying ResponseWriter, for the
+// benefit of http.ResponseController.
+func (w *sniffWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *sniffWriter) commit() {
+	if !w.committed {
+		w.flushBuffer()
+	}
+}
+
+func (w *sniffWriter) flushBuffer() error {
+	w.committed = true
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	h := w.Header()
+	if len(w.buf) > 0 && bodyAllowed(w.status) {
+		if _, ok := h["Content-Type"]; !ok {
+			h.Set("Content-Type", w.sniff().MediaType())
+		}
+		if _, ok := h["X-Content-Type-Options"]; !ok {
+			h.Set("X-Content-Type-Options", "nosniff")
+		}
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	if len(w.buf) == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *sniffWriter) sniff() MediaType {
+	if w.filename == "" {
+		return MatchMagic(w.buf)
+	}
+	return Match(w.buf, w.filename)
+}
+
+func bodyAllowed(status int) bool {
+	switch {
+	case status >= 100 && status <= 199:
+		return false
+	case status == http.StatusNoContent, status == http.StatusNotModified:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package mimemagic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestSniffHandler(t *testing.T) {
+	large := append(append([]byte{}, pngHeader...), make([]byte, 2*sniffLen)...)
+	tests := []struct {
+		name, method, path string
+		useFilename        bool
+		handler            http.HandlerFunc
+		wantType, wantOpts string
+		wantStatus         int
+		wantBody           []byte
+	}{
+		{"png", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.Write(pngHeader)
+		}, "image/png", "nosniff", http.StatusOK, pngHeader},
+		{"large body split writes", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.Write(large[:3])
+			w.Write(large[3:700])
+			w.Write(large[700:])
+		}, "image/png", "nosniff", http.StatusOK, large},
+		{"small text", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("hello"))
+		}, "text/plain", "nosniff", http.StatusOK, []byte("hello")},
+		{"filename", "GET", "/src/main.c", true, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("hello"))
+		}, "text/x-csrc", "nosniff", http.StatusOK, []byte("hello")},
+		{"filename ignored", "GET", "/src/main.c", false, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("hello"))
+		}, "text/plain", "nosniff", http.StatusOK, []byte("hello")},
+		{"handler sets type", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/x-custom")
+			w.Write(pngHeader)
+		}, "application/x-custom", "nosniff", http.StatusOK, pngHeader},
+		{"handler sets type late", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write(pngHeader[:4])
+			w.Header().Set("Content-Type", "application/x-custom")
+			w.Header().Set("X-Content-Type-Options", "custom")
+			w.Write(pngHeader[4:])
+		}, "application/x-custom", "custom", http.StatusCreated, pngHeader},
+		{"sniffing disabled", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.Header()["Content-Type"] = nil
+			w.Write(pngHeader)
+		}, "", "nosniff", http.StatusOK, pngHeader},
+		{"head", "HEAD", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.Write(pngHeader)
+		}, "image/png", "nosniff", http.StatusOK, pngHeader},
+		{"no body", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			w.Write(pngHeader)
+		}, "", "", http.StatusNoContent, nil},
+		{"empty", "GET", "/", false, func(w http.ResponseWriter, r *http.Request) {
+		}, "", "", http.StatusOK, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			SniffHandler(test.handler, test.useFilename).ServeHTTP(rec, httptest.NewRequest(test.method, test.path, nil))
+			if got := rec.Header().Get("Content-Type"); got != test.wantType {
+				t.Errorf("Content-Type = %v, want %v", got, test.wantType)
+			}
+			if got := rec.Header().Get("X-Content-Type-Options"); got != test.wantOpts {
+				t.Errorf("X-Content-Type-Options = %v, want %v", got, test.wantOpts)
+			}
+			if rec.Code != test.wantStatus {
+				t.Errorf("status = %v, want %v", rec.Code, test.wantStatus)
+			}
+			if got := rec.Body.Bytes(); !bytes.Equal(got, test.wantBody) {
+				t.Errorf("body = %q, want %q", got, test.wantBody)
+			}
+		})
+	}
+	t.Run("flush", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		SniffHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write(pngHeader)
+			w.(http.Flusher).Flush()
+			if !rec.Flushed {
+				t.Errorf("Flush() didn't reach the underlying ResponseWriter")
+			}
+			if got := rec.Body.Len(); got != len(pngHeader) {
+				t.Errorf("Flush() wrote %d bytes, want %d", got, len(pngHeader))
+			}
+			w.Header().Set("Content-Type", "application/x-custom")
+			w.Write([]byte("more"))
+		}), false).ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		if got := rec.Result().Header.Get("Content-Type"); got != "image/png" {
+			t.Errorf("Content-Type = %v, want %v", got, "image/png")
+		}
+	})
+	t.Run("panic", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ServeHTTP() didn't panic")
+				}
+			}()
+			SniffHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write(pngHeader)
+				panic(http.ErrAbortHandler)
+			}), false).ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		}()
+		if rec.Body.Len() != 0 || rec.Header().Get("Content-Type") != "" {
+			t.Errorf("a panicking handler sent %q with Content-Type %q", rec.Body.Bytes(), rec.Header().Get("Content-Type"))
+		}
+	})
+	t.Run("hijack not supported", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		SniffHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, _, err := w.(http.Hijacker).Hijack(); err != errHijackNotSupported {
+				t.Errorf("Hijack() error = %v, want %v", err, errHijackNotSupported)
+			}
+		}), false).ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	})
+	t.Run("hijack", func(t *testing.T) {
+		srv := httptest.NewServer(SniffHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("discarded"))
+			conn, rw, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("Hijack() error = %v", err)
+				return
+			}
+			defer conn.Close()
+			rw.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 8\r\nConnection: close\r\n\r\nhijacked")
+			rw.Flush()
+		}), false))
+		defer srv.Close()
+		resp, err := http.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		if string(body) != "hijacked" {
+			t.Errorf("body = %q, want %q", body, "hijacked")
+		}
+		if got := resp.Header.Get("X-Content-Type-Options"); got != "" {
+			t.Errorf("X-Content-Type-Options = %v, want none", got)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package mimemagic
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// MatchPath determines the MIME type of the file at path, first
+// looking at the file type with os.Lstat, or os.Stat if
+// followSymlinks is set, so that special files are identified
+// without being opened: inode/symlink, inode/chardevice,
+// inode/blockdevice, inode/fifo, inode/socket, inode/mount-point
+// and inode/directory. Regular files are read as in MatchFile,
+// with the same optional limit and preference.
+func MatchPath(path string, followSymlinks bool, limAndPref ...int) (MediaType, error) {
+	limit, preference := limitAndPreference(limAndPref)
+	m, _, err := matchPath(path, followSymlinks, true, limit, preference)
+	return mediaTypes[m], err
+}
+
+// matchPath identifies the file at path as MatchPath does, telling
+// mount points apart from other directories if mountPoints is set.
+func matchPath(path string, followSymlinks, mountPoints bool, limit, preference int) (int, fs.FileInfo, error) {
+	stat := os.Lstat
+	if followSymlinks {
+		stat = os.Stat
+	}
+	info, err := stat(path)
+	if err != nil {
+		return unknownType, nil, err
+	}
+	if m := pathType(path, info, mountPoints); m > -1 {
+		return m, info, nil
+	}
+	f, err := openFile(path, followSymlinks)
+	if err != nil {
+		return unknownType, info, err
+	}
+	defer f.Close()
+	// The file may have been replaced since it was stat'ed.
+	if info, err = f.Stat(); err != nil {
+		return unknownType, nil, err
+	}
+	if m := pathType(path, info, mountPoints); m > -1 {
+		return m, info, nil
+	}
+	m, err := matchReader(f, filepath.Base(path), limit, preference)
+	return m, info, err
+}
+
+// pathType returns the MIME type of the file type of info, telling
+// mount points apart if mountPoints is set, or -1 for regular files.
+func pathType(path string, info fs.FileInfo, mountPoints bool) int {
+	if mountPoints && info.IsDir() && isMountPoint(path, info) {
+		if m := lookup("inode/mount-point"); m > -1 {
+			return m
+		}
+	}
+	return inodeType(info.Mode())
+}
+
+// inodeType returns the MIME type of the file type in mode, or
+// -1 for regular files. Special files are application/octet-stream
+// if a registered database lacks their inode type.
+func inodeType(mode fs.FileMode) int {
+	var name string
+	switch {
+	case mode&fs.ModeSymlink != 0:
+		name = "inode/symlink"
+	case mode&fs.ModeNamedPipe != 0:
+		name = "inode/fifo"
+	case mode&fs.ModeSocket != 0:
+		name = "inode/socket"
+	case mode&fs.ModeCharDevice != 0:
+		name = "inode/chardevice"
+	case mode&fs.ModeDevice != 0:
+		name = "inode/blockdevice"
+	case mode.IsDir():
+		return unknownDirectory
+	default:
+		return -1
+	}
+	if m := lookup(name); m > -1 {
+		return m
+	}
+	return unknownType
+}
@@ -0,0 +1,19 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package mimemagic
+
+import (
+	"io/fs"
+	"os"
+)
+
+// isMountPoint is only implemented on Unix systems.
+func isMountPoint(string, fs.FileInfo) bool {
+	return false
+}
+
+// openFile opens the file at path for reading.
+func openFile(path string, _ bool) (*os.File, error) {
+	return os.Open(path)
+}
@@ -0,0 +1,41 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package mimemagic
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// isMountPoint reports whether the directory at path is on a
+// different device than its parent, or is the root directory.
+// The parent is left to the kernel to resolve, as cleaning the
+// path would find the parent of a symbolic link instead of the
+// one of the directory it points to.
+func isMountPoint(path string, info fs.FileInfo) bool {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return false
+	}
+	parent, err := os.Lstat(path + string(filepath.Separator) + "..")
+	if err != nil {
+		return false
+	}
+	pst, ok := parent.Sys().(*syscall.Stat_t)
+	return ok && (st.Dev != pst.Dev || st.Ino == pst.Ino)
+}
+
+// openFile opens the file at path for reading without blocking,
+// should a FIFO have taken the place of the regular file stat'ed,
+// and without following a symbolic link unless followSymlinks is
+// set.
+func openFile(path string, followSymlinks bool) (*os.File, error) {
+	flag := os.O_RDONLY | syscall.O_NONBLOCK
+	if !followSymlinks {
+		flag |= syscall.O_NOFOLLOW
+	}
+	return os.OpenFile(path, flag, 0)
+}
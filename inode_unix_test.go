@@ -0,0 +1,124 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package mimemagic
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestMatchPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inode")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	join := func(name string) string { return filepath.Join(dir, name) }
+	if err := ioutil.WriteFile(join("image.png"), pngHeader, 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := os.Mkdir(join("dir"), 0755); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+	for _, link := range [][2]string{{"image.png", "link"}, {"dir", "dirlink"}, {"missing", "dangling"}} {
+		if err := os.Symlink(link[0], join(link[1])); err != nil {
+			t.Fatalf("Symlink() error = %v", err)
+		}
+	}
+	if err := syscall.Mkfifo(join("fifo"), 0644); err != nil {
+		t.Fatalf("Mkfifo() error = %v", err)
+	}
+	l, err := net.Listen("unix", join("socket"))
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	defer l.Close()
+	tests := []struct {
+		path           string
+		followSymlinks bool
+		want           string
+		wantErr        bool
+	}{
+		{join("image.png"), false, "image/png", false},
+		{join("dir"), false, "inode/directory", false},
+		{join("link"), false, "inode/symlink", false},
+		{join("link"), true, "image/png", false},
+		{join("dirlink"), false, "inode/symlink", false},
+		{join("dirlink"), true, "inode/directory", false},
+		{join("dangling"), false, "inode/symlink", false},
+		{join("dangling"), true, "application/octet-stream", true},
+		{join("fifo"), false, "inode/fifo", false},
+		{join("socket"), false, "inode/socket", false},
+		{"/dev/null", false, "inode/chardevice", false},
+		{"/", false, "inode/mount-point", false},
+		{join("missing"), false, "application/octet-stream", true},
+	}
+	for _, test := range tests {
+		t.Run(filepath.Base(test.path), func(t *testing.T) {
+			var got MediaType
+			done := make(chan struct{})
+			go func() {
+				got, err = MatchPath(test.path, test.followSymlinks)
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("MatchPath() blocked")
+			}
+			if (err != nil) != test.wantErr {
+				t.Errorf("MatchPath() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got.MediaType() != test.want {
+				t.Errorf("MatchPath() = %v, want %v", got.MediaType(), test.want)
+			}
+		})
+	}
+	t.Run("MatchFilePath fifo", func(t *testing.T) {
+		got, err := MatchFilePath(join("fifo"))
+		if err != nil || got.MediaType() != "inode/fifo" {
+			t.Errorf("MatchFilePath() = %v, %v, want inode/fifo", got.MediaType(), err)
+		}
+	})
+	t.Run("symlink to another filesystem", func(t *testing.T) {
+		// /proc/self/fd isn't a mount point, but it's on another
+		// filesystem than the symbolic link to it.
+		if _, err := os.Stat("/proc/self/fd"); err != nil {
+			t.Skip("no /proc/self/fd")
+		}
+		if err := os.Symlink("/proc/self/fd", join("proclink")); err != nil {
+			t.Fatalf("Symlink() error = %v", err)
+		}
+		got, err := MatchPath(join("proclink"), true)
+		if err != nil || got.MediaType() != "inode/directory" {
+			t.Errorf("MatchPath() = %v, %v, want inode/directory", got.MediaType(), err)
+		}
+	})
+	t.Run("MatchFilePath mount point", func(t *testing.T) {
+		got, err := MatchFilePath("/")
+		if err != nil || got.MediaType() != "inode/directory" {
+			t.Errorf("MatchFilePath() = %v, %v, want inode/directory", got.MediaType(), err)
+		}
+	})
+	t.Run("openFile fifo", func(t *testing.T) {
+		// A FIFO taking the place of a file between the stat and
+		// the open mustn't block.
+		f, err := openFile(join("fifo"), false)
+		if err != nil {
+			t.Fatalf("openFile() error = %v", err)
+		}
+		defer f.Close()
+		if info, err := f.Stat(); err != nil || info.Mode()&os.ModeNamedPipe == 0 {
+			t.Errorf("Stat() = %v, %v, want a FIFO", info, err)
+		}
+		if _, err := openFile(join("link"), false); err == nil {
+			t.Errorf("openFile() of a symbolic link error = nil")
+		}
+	})
+}
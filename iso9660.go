@@ -0,0 +1,288 @@
+package mimemagic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// maxDirLen caps the size of a directory read from a disc image,
+// so a corrupt one can't exhaust the memory.
+const maxDirLen = 16 << 20
+
+var isoIdentifier = []byte("CD001")
+
+type isoReader struct {
+	r           io.ReaderAt
+	block       int64
+	joliet      bool
+	rockRidge   bool
+	suspSkip    int
+	jolietRoot  []byte
+	primaryRoot []byte
+}
+
+// NewISO9660FS returns a read-only fs.FS over the ISO 9660 disc
+// image r. Names are taken from the Rock Ridge extensions if
+// present, or else from the Joliet ones, falling back to the
+// plain ISO 9660 names with the version suffix removed. Without
+// Rock Ridge, files are reported executable, as they are when
+// mounted. It returns ErrNotDiscImage if r isn't an ISO 9660
+// image.
+func NewISO9660FS(r io.ReaderAt) (fs.FS, error) {
+	x := &isoReader{r: r}
+	for n := int64(16); n < 16+64; n++ {
+		b, err := readSector(r, n)
+		if err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(b[1:6], isoIdentifier) {
+			break
+		}
+		switch b[0] {
+		case 1:
+			if x.primaryRoot == nil {
+				x.primaryRoot = b[156 : 156+34]
+				x.block = int64(binary.LittleEndian.Uint16(b[128:]))
+			}
+		case 2:
+			switch string(b[88:91]) {
+			case "%/@", "%/C", "%/E":
+				x.jolietRoot = b[156 : 156+34]
+			}
+		}
+		if b[0] == 255 {
+			break
+		}
+	}
+	if x.primaryRoot == nil {
+		return nil, ErrNotDiscImage
+	}
+	if x.block == 0 {
+		x.block = sectorLen
+	}
+	root := x.entry(x.primaryRoot)
+	if err := x.detectRockRidge(root); err != nil {
+		return nil, err
+	}
+	if !x.rockRidge && x.jolietRoot != nil {
+		x.joliet = true
+		root = x.entry(x.jolietRoot)
+	}
+	root.name, root.mode = ".", fs.ModeDir|0555
+	return &discFS{r: r, root: root, readDir: x.readDir}, nil
+}
+
+// detectRockRidge looks for the SUSP indicator in the system use
+// area of the "." entry of the root directory.
+func (x *isoReader) detectRockRidge(root *discEntry) error {
+	b, err := readAt(x.r, root.loc, min64(x.block, root.size))
+	if err != nil {
+		return err
+	}
+	if len(b) < 34 || int(b[0]) < 34 || int(b[0]) > len(b) {
+		return nil
+	}
+	sua := b[34:b[0]]
+	if len(sua) >= 7 && string(sua[:2]) == "SP" && sua[4] == 0xbe && sua[5] == 0xef {
+		x.rockRidge, x.suspSkip = true, int(sua[6])
+	}
+	return nil
+}
+
+// entry parses the directory record rec, without its name.
+func (x *isoReader) entry(rec []byte) *discEntry {
+	e := &discEntry{
+		loc:     int64(binary.LittleEndian.Uint32(rec[2:])) * x.block,
+		size:    int64(binary.LittleEndian.Uint32(rec[10:])),
+		modTime: isoTime(rec[18:25]),
+		mode:    0555,
+	}
+	if rec[25]&2 != 0 {
+		e.mode |= fs.ModeDir
+	} else {
+		e.segments = []discSegment{{off: e.loc, len: e.size}}
+	}
+	return e
+}
+
+func (x *isoReader) readDir(dir *discEntry) ([]*discEntry, error) {
+	data, err := readAt(x.r, dir.loc, min64(dir.size, maxDirLen))
+	if err != nil {
+		return nil, err
+	}
+	var entries []*discEntry
+	var multiExtent bool
+	for pos := 0; pos < len(data); {
+		l := int(data[pos])
+		if l == 0 {
+			// Records don't cross block boundaries.
+			pos = (pos/int(x.block) + 1) * int(x.block)
+			continue
+		}
+		if l < 34 || pos+l > len(data) {
+			break
+		}
+		rec := data[pos : pos+l]
+		pos += l
+		nameLen := int(rec[32])
+		if 33+nameLen > l || rec[25]&4 != 0 {
+			continue
+		}
+		name := rec[33 : 33+nameLen]
+		if nameLen == 1 && name[0] <= 1 {
+			continue
+		}
+		e := x.entry(rec)
+		e.name = x.name(name)
+		// The system use area follows the name, padded to an
+		// even length.
+		if sua := 33 + nameLen + 1 - nameLen%2 + x.suspSkip; x.rockRidge && sua <= l {
+			if !x.rockRidgeEntry(e, rec[sua:]) {
+				continue
+			}
+		}
+		// Files of 4 GiB or more span several records.
+		if n := len(entries); multiExtent && n > 0 && entries[n-1].name == e.name {
+			entries[n-1].size += e.size
+			entries[n-1].segments = append(entries[n-1].segments, e.segments...)
+		} else {
+			entries = append(entries, e)
+		}
+		multiExtent = rec[25]&0x80 != 0
+	}
+	return entries, nil
+}
+
+func (x *isoReader) name(b []byte) string {
+	var s string
+	if x.joliet {
+		u := make([]uint16, len(b)/2)
+		for i := range u {
+			u[i] = binary.BigEndian.Uint16(b[2*i:])
+		}
+		s = string(utf16.Decode(u))
+	} else {
+		s = string(b)
+	}
+	if i := strings.LastIndexByte(s, ';'); i > -1 {
+		s = s[:i]
+	}
+	return strings.TrimSuffix(s, ".")
+}
+
+// rockRidgeEntry applies the Rock Ridge extensions in the system
+// use area sua to e, and reports whether e is to be listed, as
+// relocated directories are listed at their original location.
+func (x *isoReader) rockRidgeEntry(e *discEntry, sua []byte) bool {
+	var name []byte
+	var hasName, relocated bool
+	var childLink int64 = -1
+	x.susp(sua, func(sig string, data []byte) {
+		switch sig {
+		case "NM":
+			if len(data) > 0 && data[0]&6 == 0 {
+				name, hasName = append(name, data[1:]...), true
+			}
+		case "PX":
+			if len(data) >= 4 {
+				e.mode = posixMode(binary.LittleEndian.Uint32(data))
+			}
+		case "SL":
+			e.mode = fs.ModeSymlink | e.mode.Perm()
+		case "CL":
+			if len(data) >= 4 {
+				childLink = int64(binary.LittleEndian.Uint32(data)) * x.block
+			}
+		case "RE":
+			relocated = true
+		}
+	})
+	if hasName {
+		e.name = string(name)
+	}
+	if childLink > -1 {
+		e.mode = fs.ModeDir | e.mode.Perm()
+		e.loc, e.segments = childLink, nil
+		if b, err := readAt(x.r, childLink, 34); err == nil {
+			e.size = int64(binary.LittleEndian.Uint32(b[10:]))
+		}
+	}
+	if e.mode.IsDir() || e.mode&fs.ModeSymlink != 0 {
+		e.segments = nil
+	}
+	if e.mode&fs.ModeSymlink != 0 {
+		e.size = 0
+	}
+	return !relocated
+}
+
+// susp calls fn with the signature and data of every System Use
+// Sharing Protocol entry in sua, following continuation areas.
+func (x *isoReader) susp(sua []byte, fn func(sig string, data []byte)) {
+	for i := 0; i < 16 && len(sua) > 0; i++ {
+		var next []byte
+		for len(sua) >= 4 {
+			l := int(sua[2])
+			if l < 4 || l > len(sua) {
+				break
+			}
+			sig, data := string(sua[:2]), sua[4:l]
+			sua = sua[l:]
+			if sig == "ST" {
+				break
+			}
+			if sig == "CE" && len(data) >= 24 {
+				off := int64(binary.LittleEndian.Uint32(data))*x.block + int64(binary.LittleEndian.Uint32(data[8:]))
+				next, _ = readAt(x.r, off, min64(int64(binary.LittleEndian.Uint32(data[16:])), x.block))
+				continue
+			}
+			fn(sig, data)
+		}
+		sua = next
+	}
+}
+
+// posixMode converts a POSIX st_mode to an fs.FileMode.
+func posixMode(m uint32) fs.FileMode {
+	mode := fs.FileMode(m & 0777)
+	switch m & 0170000 {
+	case 0040000:
+		mode |= fs.ModeDir
+	case 0120000:
+		mode |= fs.ModeSymlink
+	case 0020000:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case 0060000:
+		mode |= fs.ModeDevice
+	case 0010000:
+		mode |= fs.ModeNamedPipe
+	case 0140000:
+		mode |= fs.ModeSocket
+	}
+	if m&04000 != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if m&02000 != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if m&01000 != 0 {
+		mode |= fs.ModeSticky
+	}
+	return mode
+}
+
+// isoTime parses a 7 byte ISO 9660 directory record timestamp.
+func isoTime(b []byte) time.Time {
+	if b[0] == 0 && b[1] == 0 {
+		return time.Time{}
+	}
+	loc := time.FixedZone("", int(int8(b[6]))*15*60)
+	return time.Date(1900+int(b[0]), time.Month(b[1]), int(b[2]), int(b[3]), int(b[4]), int(b[5]), 0, loc)
+}
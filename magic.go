@@ -7,8 +7,8 @@ import (
 var utf16beBOM, utf16leBOM, utf8BOM = []byte{0xfe, 0xff}, []byte{0xff, 0xfe}, []byte{0xef, 0xbb, 0xbf}
 
 type magic struct {
-	mediaType int
-	matchers  []*magicMatch
+	mediaType, priority int
+	matchers            []*magicMatch
 }
 
 type magicMatch struct {
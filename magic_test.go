@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -476,6 +477,31 @@ func TestMatchMagic(t *testing.T) {
 	})
 }
 
+// TestMatchMagicTies checks the MIME types detected for content that
+// the signatures of several MIME types of the same priority match,
+// which depend on the order the tables are generated in.
+func TestMatchMagicTies(t *testing.T) {
+	zip := func(mimeType string) string {
+		return "PK\x03\x04" + strings.Repeat("\x00", 26) + "mimetype" + mimeType
+	}
+	tests := []struct {
+		name, data, want string
+	}{
+		{"writer template", zip("application/vnd.sun.xml.writer"), "application/vnd.sun.xml.writer.template"},
+		{"impress template", zip("application/vnd.sun.xml.impress"), "application/vnd.sun.xml.impress.template"},
+		{"esri shape index", "\x00\x00\x27\x0a", "application/x-esri-shape-index"},
+		{"wavpack correction", "wvpk", "audio/x-wavpack-correction"},
+		{"annodex", "OggS" + strings.Repeat("\x00", 24) + "fishead\x00" + strings.Repeat("\x00", 20) + "CMML\x00\x00\x00\x00", "video/annodex"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := MatchMagic([]byte(test.data)).MediaType(); got != test.want {
+				t.Errorf("MatchMagic() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
 func benchmarkMatchMagic(filename string, b *testing.B) {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -1,499 +1,478 @@
+// Code generated by cmd/parser from shared-mime-info packages sha256:be627d70848ab71c00c6fef872059a0448fdd73c14bedff7ffb98c5f51697803. DO NOT EDIT.
+
 package mimemagic
 
-const magicMaxLen = 18729
+var magicMaxLen = 18729
 
 var magicSignatures = []magic{
-	{247, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{0, 100, []byte{0x2d, 0x2f, 0x2f, 0x4f, 0x41, 0x53, 0x49, 0x53, 0x2f, 0x2f, 0x44, 0x54, 0x44, 0x20, 0x44, 0x6f, 0x63, 0x42, 0x6f, 0x6f, 0x6b, 0x20, 0x58, 0x4d, 0x4c}, nil, nil}, {0, 100, []byte{0x2d, 0x2f, 0x2f, 0x4b, 0x44, 0x45, 0x2f, 0x2f, 0x44, 0x54, 0x44, 0x20, 0x44, 0x6f, 0x63, 0x42, 0x6f, 0x6f, 0x6b, 0x20, 0x58, 0x4d, 0x4c}, nil, nil}}}}},
-	{170, []*magicMatch{{2089, 0, []byte{0x53, 0x74, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}},
-	{641, []*magicMatch{{0, 0, []byte{0x25, 0x21}, nil, []*magicMatch{{15, 0, []byte{0x45, 0x50, 0x53}, nil, nil}}}, {0, 0, []byte{0x4, 0x25, 0x21}, nil, []*magicMatch{{16, 0, []byte{0x45, 0x50, 0x53}, nil, nil}}}, {0, 0, []byte{0xc5, 0xd0, 0xd3, 0xc6}, nil, nil}}},
-	{379, []*magicMatch{{0, 64, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x4e, 0x45, 0x54, 0x53, 0x43, 0x41, 0x50, 0x45, 0x2d, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x2d, 0x66, 0x69, 0x6c, 0x65, 0x2d, 0x31, 0x3e}, nil, nil}}},
-	{249, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31, 0x2e, 0x30, 0x22, 0x3f, 0x3e, 0xa, 0x3c, 0x64, 0x72, 0x67, 0x65, 0x6e, 0x69, 0x75, 0x73, 0x3e}, nil, nil}}},
-	{249, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31, 0x2e, 0x30, 0x22, 0x3f, 0x3e, 0xa, 0x3c, 0x64, 0x72, 0x67, 0x65, 0x6e, 0x69, 0x75, 0x73, 0x3e}, nil, nil}}},
-	{185, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65}, nil, nil}}},
-	{657, []*magicMatch{{242, 0, []byte{0x45, 0x41, 0x53, 0x54, 0x4d, 0x41, 0x4e, 0x20, 0x4b, 0x4f, 0x44, 0x41, 0x4b, 0x20, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x4e, 0x59}, nil, nil}}},
-	{340, []*magicMatch{{0, 0, []byte{0x5b, 0x30, 0x2d, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x40, 0x2d, 0x35, 0x30, 0x2c, 0x2d, 0x35, 0x30, 0x5d}, nil, nil}}},
-	{340, []*magicMatch{{0, 0, []byte{0x5b, 0x30, 0x2d, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x40, 0x2d, 0x35, 0x30, 0x2c, 0x2d, 0x35, 0x30, 0x5d}, nil, nil}}},
-	{221, []*magicMatch{{0, 0, []byte{0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x20, 0x43, 0x61, 0x62, 0x72, 0x69, 0x49, 0x49, 0x20, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x20}, nil, nil}, {0, 0, []byte{0x46, 0x69, 0x67, 0x75, 0x72, 0x65, 0x20, 0x43, 0x61, 0x62, 0x72, 0x69, 0x20, 0x49, 0x49, 0x20}, nil, nil}}},
-	{221, []*magicMatch{{0, 0, []byte{0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x20, 0x43, 0x61, 0x62, 0x72, 0x69, 0x49, 0x49, 0x20, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x20}, nil, nil}, {0, 0, []byte{0x46, 0x69, 0x67, 0x75, 0x72, 0x65, 0x20, 0x43, 0x61, 0x62, 0x72, 0x69, 0x20, 0x49, 0x49, 0x20}, nil, nil}}},
-	{184, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x63, 0x64, 0x66}, nil, nil}}},
-	{186, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x6e, 0x62}, nil, nil}, {0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}, {0, 100, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}}},
-	{336, []*magicMatch{{0, 0, []byte{0x5b, 0x30, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x47, 0x61, 0x6d, 0x65, 0x5d}, nil, nil}}},
-	{336, []*magicMatch{{0, 0, []byte{0x5b, 0x30, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x47, 0x61, 0x6d, 0x65, 0x5d}, nil, nil}}},
-	{187, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x77, 0x6c}, nil, nil}}},
-	{91, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x6b, 0x63, 0x66, 0x67}, nil, nil}}},
-	{95, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x67, 0x75, 0x69}, nil, nil}, {0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x6b, 0x70, 0x61, 0x72, 0x74, 0x67, 0x75, 0x69}, nil, nil}}},
-	{611, []*magicMatch{{0, 0, []byte{0xab, 0x4b, 0x54, 0x58}, nil, []*magicMatch{{4, 0, []byte{0x20, 0x31, 0x31, 0xbb}, nil, []*magicMatch{{8, 0, []byte{0xd, 0xa, 0x1a, 0xa}, nil, nil}}}}}}},
-	{572, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x4f, 0x70, 0x75, 0x73, 0x48, 0x65, 0x61, 0x64}, nil, nil}}}}},
-	{257, []*magicMatch{{0, 256, []byte{0x3c, 0x46, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6b}, nil, nil}}},
-	{581, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x53, 0x70, 0x65, 0x65, 0x78, 0x20, 0x20}, nil, nil}}}}},
-	{585, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x1, 0x76, 0x6f, 0x72, 0x62, 0x69, 0x73}, nil, nil}}}}},
-	{983, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x80, 0x74, 0x68, 0x65, 0x6f, 0x72, 0x61}, nil, nil}}}}},
-	{915, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x71, 0x6d, 0x6c}, nil, nil}, {0, 3000, []byte{0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x51, 0x74}, nil, []*magicMatch{{9, 3000, []byte{0x7b}, nil, nil}}}, {0, 3000, []byte{0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x51, 0x6d, 0x6c}, nil, []*magicMatch{{9, 3000, []byte{0x7b}, nil, nil}}}}},
-	{915, []*magicMatch{{0, 256, []byte{0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x51, 0x74, 0x20}, nil, nil}}},
-	{915, []*magicMatch{{0, 256, []byte{0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x51, 0x74, 0x20}, nil, nil}}},
-	{981, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{29, 0, []byte{0x76, 0x69, 0x64, 0x65, 0x6f}, nil, nil}}}}},
-	{619, []*magicMatch{{0, 0, []byte{0x41, 0x54, 0x26, 0x54, 0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{12, 0, []byte{0x44, 0x4a, 0x56, 0x55}, nil, nil}}}, {0, 0, []byte{0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{8, 0, []byte{0x44, 0x4a, 0x56, 0x55}, nil, nil}}}}},
-	{558, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x4c, 0x61, 0x43}, nil, nil}}}, {0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x7f, 0x46, 0x4c, 0x41, 0x43}, nil, nil}}}}},
-	{620, []*magicMatch{{0, 0, []byte{0x41, 0x54, 0x26, 0x54, 0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{12, 0, []byte{0x44, 0x4a, 0x56, 0x4d}, nil, nil}}}, {0, 0, []byte{0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{8, 0, []byte{0x44, 0x4a, 0x56, 0x4d}, nil, nil}}}}},
-	{55, []*magicMatch{{60, 0, []byte{0x44, 0x61, 0x74, 0x61, 0x50, 0x6c, 0x6b, 0x72}, nil, nil}}},
-	{76, []*magicMatch{{8, 0, []byte{0x43, 0x44, 0x52, 0x0, 0x76, 0x72, 0x73, 0x6e}, []byte{0xff, 0xff, 0xff, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
-	{378, []*magicMatch{{60, 0, []byte{0x42, 0x4f, 0x4f, 0x4b, 0x4d, 0x4f, 0x42, 0x49}, nil, nil}}},
-	{378, []*magicMatch{{60, 0, []byte{0x42, 0x4f, 0x4f, 0x4b, 0x4d, 0x4f, 0x42, 0x49}, nil, nil}}},
-	{353, []*magicMatch{{0, 0, []byte{0x4b, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54}, nil, nil}}},
-	{353, []*magicMatch{{0, 0, []byte{0x4b, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54}, nil, nil}}},
-	{526, []*magicMatch{{0, 256, []byte{0x3c, 0x78, 0x6c, 0x69, 0x66, 0x66}, nil, nil}}},
-	{410, []*magicMatch{{0, 64, []byte{0x3c, 0x3f, 0x70, 0x68, 0x70}, nil, nil}}},
-	{615, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x73, 0x76, 0x67}, nil, nil}, {0, 256, []byte{0x3c, 0x73, 0x76, 0x67}, nil, nil}}},
-	{837, []*magicMatch{{0, 0, []byte{0x25, 0x61, 0x62, 0x63}, nil, nil}}},
-	{837, []*magicMatch{{0, 0, []byte{0x25, 0x61, 0x62, 0x63}, nil, nil}}},
-	{663, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x4e, 0x31}, nil, nil}}},
-	{396, []*magicMatch{{0, 256, []byte{0x3c, 0x6e, 0x7a, 0x62}, nil, nil}}},
-	{670, []*magicMatch{{0, 0, []byte{0x53, 0x80, 0xf6, 0x34}, nil, nil}}},
-	{670, []*magicMatch{{0, 0, []byte{0x53, 0x80, 0xf6, 0x34}, nil, nil}}},
-	{396, []*magicMatch{{0, 256, []byte{0x3c, 0x6e, 0x7a, 0x62}, nil, nil}}},
-	{400, []*magicMatch{{0, 256, []byte{0x3c, 0x6f, 0x73, 0x6d}, nil, nil}}},
-	{402, []*magicMatch{{0, 0, []byte{0x50, 0x41, 0x43, 0x4b}, nil, nil}}},
-	{40, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x20, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x4e, 0x45, 0x57, 0x20, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x20, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{408, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x52, 0x53, 0x41, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x44, 0x53, 0x41, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x45, 0x43, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{47, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x45, 0x44, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{50, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{52, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x58, 0x35, 0x30, 0x39, 0x20, 0x43, 0x52, 0x4c, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{42, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x32, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{44, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x4b, 0x43, 0x53, 0x37, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{450, []*magicMatch{{0, 0, []byte{0x53, 0x50, 0x4b, 0x41, 0x43, 0x3d}, nil, nil}}},
-	{139, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{142, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{135, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{132, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{129, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{147, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{137, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}}}}}}},
-	{140, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}}}}}},
-	{145, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
-	{133, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73}, nil, nil}}}}}}},
-	{148, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x77, 0x65, 0x62}, nil, nil}}}}}}},
-	{131, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61}, nil, nil}}}}}}},
-	{136, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x69, 0x6d, 0x61, 0x67, 0x65}, nil, nil}}}}}}},
-	{128, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x74}, nil, nil}}}}}}},
-	{143, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74}, nil, nil}}}}}}},
-	{130, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65}, nil, nil}}}}}}},
-	{176, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73}, nil, nil}}}}}}},
-	{175, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73}, nil, nil}}}}}}},
-	{180, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
-	{178, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
-	{179, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
-	{173, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x64, 0x72, 0x61, 0x77}, nil, nil}}}}}}},
-	{171, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x63, 0x61, 0x6c, 0x63}, nil, nil}}}}}}},
-	{172, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x63, 0x61, 0x6c, 0x63}, nil, nil}}}}}}},
-	{174, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x64, 0x72, 0x61, 0x77}, nil, nil}}}}}}},
-	{177, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x6d, 0x61, 0x74, 0x68}, nil, nil}}}}}}},
-	{9, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x70, 0x75, 0x62, 0x2b, 0x7a, 0x69, 0x70}, nil, nil}, {43, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x70, 0x75, 0x62, 0x2b, 0x7a, 0x69, 0x70}, nil, nil}}}}}}},
-	{612, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x73, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
-	{71, []*magicMatch{{0, 0, []byte{0x23, 0x45, 0x58, 0x54, 0x4d, 0x33, 0x55}, nil, []*magicMatch{{0, 128, []byte{0x23, 0x45, 0x58, 0x54, 0x2d, 0x58, 0x2d, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}, {0, 128, []byte{0x23, 0x45, 0x58, 0x54, 0x2d, 0x58, 0x2d, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x2d, 0x49, 0x4e, 0x46}, nil, nil}}}}},
-	{307, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x61, 0x70, 0x78, 0x6c}, nil, nil}}}}},
-	{523, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 226, []byte{0x2e, 0x66, 0x62, 0x32}, nil, nil}}}}},
-	{926, []*magicMatch{{0, 0, []byte{0x53, 0x30}, nil, []*magicMatch{{4, 0, []byte{0x30, 0x30, 0x30, 0x30}, nil, nil}}}}},
-	{910, []*magicMatch{{0, 256, []byte{0x3c, 0x6f, 0x70, 0x6d, 0x6c, 0x20}, nil, nil}}},
-	{4, []*magicMatch{{0, 256, []byte{0x3c, 0x66, 0x65, 0x65, 0x64, 0x20}, nil, nil}}},
-	{61, []*magicMatch{{0, 256, []byte{0x3c, 0x72, 0x73, 0x73, 0x20}, nil, nil}, {0, 256, []byte{0x3c, 0x52, 0x53, 0x53, 0x20}, nil, nil}}},
-	{881, []*magicMatch{{0, 0, []byte{0x3a}, nil, nil}}},
-	{138, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
-	{141, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
-	{134, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
-	{144, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
-	{139, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{146, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{142, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{135, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{147, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
-	{145, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
-	{148, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x77, 0x65, 0x62}, nil, nil}}}}}}},
-	{332, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x69, 0x6c, 0x6c, 0x75, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4, 0x6}, nil, nil}}}}}}},
-	{342, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
-	{327, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61}, nil, nil}}}}}}},
-	{345, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64}, nil, nil}}}}}}},
-	{338, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72}, nil, nil}}}}}}},
-	{317, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x61, 0x72, 0x62, 0x6f, 0x6e}, nil, nil}}}}}}},
-	{319, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x63, 0x68, 0x61, 0x72, 0x74, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x63, 0x68, 0x61, 0x72, 0x74}, nil, nil}}}}}}},
-	{333, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x69, 0x76, 0x69, 0x6f, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x69, 0x76, 0x69, 0x6f}, nil, nil}}}}}}},
-	{343, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x72, 0x69, 0x74, 0x61, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x72, 0x69, 0x74, 0x61}, nil, nil}}}}}}},
-	{354, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x77, 0x6f, 0x72, 0x64, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x77, 0x6f, 0x72, 0x64}, nil, nil}}}}}}},
-	{434, []*magicMatch{{0, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x44, 0x49, 0x53, 0x43, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d}, nil, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41}, nil, nil}}}, {16, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x44, 0x49, 0x53, 0x43, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d}, nil, []*magicMatch{{272, 0, []byte{0x53, 0x45, 0x47, 0x41}, nil, nil}}}}},
-	{525, []*magicMatch{{0, 256, []byte{0x2f, 0x2f, 0x57, 0x33, 0x43, 0x2f, 0x2f, 0x44, 0x54, 0x44, 0x20, 0x58, 0x48, 0x54, 0x4d, 0x4c, 0x20}, nil, nil}, {0, 256, []byte{0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x77, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x54, 0x52, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c, 0x31, 0x2f, 0x44, 0x54, 0x44, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c, 0x31, 0x2d, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x2e, 0x64, 0x74, 0x64}, nil, nil}, {0, 256, []byte{0x3c, 0x68, 0x74, 0x6d, 0x6c, 0x20, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x3d, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x77, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x31, 0x39, 0x39, 0x39, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}, {0, 256, []byte{0x3c, 0x48, 0x54, 0x4d, 0x4c, 0x20, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x3d, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x77, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x31, 0x39, 0x39, 0x39, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}}},
-	{914, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}}},
-	{939, []*magicMatch{{0, 0, []byte{0x25, 0x21, 0x70, 0x6f, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x63}, nil, nil}, {0, 0, []byte{0x25, 0x21, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67}, nil, nil}}},
-	{368, []*magicMatch{{0, 0, []byte{0x89, 0x4c, 0x5a, 0x4f, 0x0, 0xd, 0xa, 0x1a, 0xa}, nil, nil}}},
-	{422, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{0, 64, []byte{0x3c, 0x3f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65}, nil, nil}}}, {0, 0, []byte{0x52, 0x54, 0x53, 0x50, 0x74, 0x65, 0x78, 0x74}, nil, nil}, {0, 0, []byte{0x72, 0x74, 0x73, 0x70, 0x74, 0x65, 0x78, 0x74}, nil, nil}, {0, 0, []byte{0x53, 0x4d, 0x49, 0x4c, 0x74, 0x65, 0x78, 0x74}, nil, nil}}},
-	{102, []*magicMatch{{0, 0, []byte{0x4d, 0x53, 0x43, 0x46, 0x0, 0x0, 0x0, 0x0}, nil, nil}}},
-	{418, []*magicMatch{{0, 0, []byte{0x71, 0x70, 0x72, 0x65, 0x73, 0x73, 0x31, 0x30}, nil, nil}}},
-	{193, []*magicMatch{{7, 0, []byte{0x2a, 0x2a, 0x41, 0x43, 0x45, 0x2a, 0x2a}, nil, nil}}},
-	{460, []*magicMatch{{257, 0, []byte{0x75, 0x73, 0x74, 0x61, 0x72, 0x0}, nil, nil}, {257, 0, []byte{0x75, 0x73, 0x74, 0x61, 0x72, 0x20, 0x20, 0x0}, nil, nil}}},
-	{191, []*magicMatch{{0, 0, []byte{0x37, 0x7a, 0xbc, 0xaf, 0x27, 0x1c}, nil, nil}}},
-	{518, []*magicMatch{{0, 0, []byte{0xfd, 0x37, 0x7a, 0x58, 0x5a, 0x0}, nil, nil}}},
-	{357, []*magicMatch{{2, 0, []byte{0x2d, 0x6c, 0x68, 0x20, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x30, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x31, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x32, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x33, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x34, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x35, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x34, 0x30, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x64, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x7a, 0x34, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x7a, 0x35, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x7a, 0x73, 0x2d}, nil, nil}}},
-	{126, []*magicMatch{{0, 256, []byte{0x3c, 0x3f, 0x77, 0x70, 0x6c}, nil, nil}}},
-	{27, []*magicMatch{{0, 0, []byte{0x31, 0xbe, 0x0, 0x0}, nil, nil}, {0, 0, []byte{0x50, 0x4f, 0x5e, 0x51, 0x60}, nil, nil}, {0, 0, []byte{0xfe, 0x37, 0x0, 0x23}, nil, nil}, {0, 0, []byte{0xdb, 0xa5, 0x2d, 0x0, 0x0, 0x0}, nil, nil}, {2112, 0, []byte{0x4d, 0x53, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x6f, 0x63}, nil, nil}, {2108, 0, []byte{0x4d, 0x53, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x6f, 0x63}, nil, nil}, {2112, 0, []byte{0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x20, 0x57, 0x6f, 0x72, 0x64, 0x20, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x64, 0x61, 0x74, 0x61}, nil, nil}, {546, 0, []byte{0x62, 0x6a, 0x62, 0x6a}, nil, nil}, {546, 0, []byte{0x6a, 0x62, 0x6a, 0x62}, nil, nil}}},
-	{272, []*magicMatch{{0, 0, []byte{0x4c, 0x57, 0x46, 0x4e}, nil, nil}, {65, 0, []byte{0x4c, 0x57, 0x46, 0x4e}, nil, nil}, {0, 0, []byte{0x25, 0x21, 0x50, 0x53, 0x2d, 0x41, 0x64, 0x6f, 0x62, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x2d, 0x31, 0x2e}, nil, nil}, {6, 0, []byte{0x25, 0x21, 0x50, 0x53, 0x2d, 0x41, 0x64, 0x6f, 0x62, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x2d, 0x31, 0x2e}, nil, nil}, {0, 0, []byte{0x25, 0x21, 0x46, 0x6f, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x31, 0x2d, 0x31, 0x2e}, nil, nil}, {6, 0, []byte{0x25, 0x21, 0x46, 0x6f, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x31, 0x2d, 0x31, 0x2e}, nil, nil}}},
-	{203, []*magicMatch{{0, 0, []byte{0x1a, 0x8, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x9, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x2, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x3, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x4, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x6, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}}},
-	{454, []*magicMatch{{0, 0, []byte{0x53, 0x74, 0x75, 0x66, 0x66, 0x49, 0x74, 0x20}, nil, nil}, {0, 0, []byte{0x53, 0x49, 0x54, 0x21}, nil, nil}}},
-	{362, []*magicMatch{{0, 0, []byte{0x4, 0x22, 0x4d, 0x18}, nil, nil}, {0, 0, []byte{0x2, 0x21, 0x4c, 0x18}, nil, nil}}},
-	{533, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, nil}}},
-	{403, []*magicMatch{{0, 0, []byte{0x50, 0x41, 0x52, 0x32}, nil, nil}}},
-	{364, []*magicMatch{{0, 0, []byte{0x4c, 0x5a, 0x49, 0x50}, nil, nil}}},
-	{314, []*magicMatch{{0, 0, []byte{0xca, 0xfe, 0xd0, 0xd}, nil, nil}}},
-	{359, []*magicMatch{{0, 0, []byte{0x4c, 0x52, 0x5a, 0x49}, nil, nil}}},
-	{159, []*magicMatch{{0, 0, []byte{0x52, 0x61, 0x72, 0x21}, nil, nil}}},
-	{515, []*magicMatch{{0, 0, []byte{0x78, 0x61, 0x72, 0x21}, nil, nil}}},
-	{524, []*magicMatch{{20, 0, []byte{0xdc, 0xa7, 0xc4, 0xfd}, nil, nil}}},
-	{550, []*magicMatch{{0, 18725, []byte{0x64, 0x58, 0x20, 0x25}, nil, nil}}},
-	{235, []*magicMatch{{0, 0, []byte{0x71, 0xc7}, nil, nil}, {0, 0, []byte{0x30, 0x37, 0x30, 0x37, 0x30, 0x31}, nil, nil}, {0, 0, []byte{0x30, 0x37, 0x30, 0x37, 0x30, 0x32}, nil, nil}, {0, 0, []byte{0xc7, 0x71}, nil, nil}}},
-	{65, []*magicMatch{{0, 256, []byte{0x3c, 0x73, 0x6d, 0x69, 0x6c}, nil, nil}}},
-	{569, []*magicMatch{{0, 0, []byte{0x41, 0x53, 0x46, 0x20}, nil, nil}, {0, 64, []byte{0x3c, 0x41, 0x53, 0x58}, nil, nil}, {0, 64, []byte{0x3c, 0x61, 0x73, 0x78}, nil, nil}, {0, 64, []byte{0x3c, 0x41, 0x73, 0x78}, nil, nil}}},
-	{468, []*magicMatch{{1, 0, []byte{0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x45, 0x20, 0x44, 0x27, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x41, 0x47, 0x45, 0x20, 0x50, 0x55, 0x4b, 0x41, 0x4c, 0x4c, 0x20, 0x53, 0x2e, 0x41, 0x2e, 0x50, 0x2e, 0x20, 0x28, 0x63, 0x29, 0x20, 0x41, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64, 0x72, 0x65, 0x20, 0x50, 0x55, 0x4b, 0x41, 0x4c, 0x4c, 0x20, 0x41, 0x76, 0x72, 0x69, 0x6c, 0x20, 0x31, 0x39, 0x39, 0x38}, nil, nil}}},
-	{137, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}}}}}}},
-	{140, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}}}}}},
-	{133, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73}, nil, nil}}}}}}},
-	{131, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61}, nil, nil}}}}}}},
-	{22, []*magicMatch{{0, 0, []byte{0x28, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x20, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}, {100, 156, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x72, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}, {10, 246, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x20, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x20, 0x20, 0x49, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x41, 0x53, 0x43, 0x49, 0x49, 0x20, 0x74, 0x65, 0x78, 0x74}, nil, nil}}},
-	{271, []*magicMatch{{0, 256, []byte{0x3c, 0x74, 0x74, 0x46, 0x6f, 0x6e, 0x74, 0x20, 0x73, 0x66, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x5c, 0x78, 0x30, 0x30, 0x5c, 0x78, 0x30, 0x31, 0x5c, 0x78, 0x30, 0x30, 0x5c, 0x78, 0x30, 0x30, 0x22, 0x20, 0x74, 0x74, 0x4c, 0x69, 0x62, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22}, nil, nil}}},
-	{143, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74}, nil, nil}}}}}}},
-	{871, []*magicMatch{{0, 256, []byte{0x23, 0x2c, 0x20, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0xa, 0x6d, 0x73, 0x67, 0x69, 0x64, 0x20, 0x22, 0x22, 0xa, 0x6d, 0x73, 0x67, 0x73, 0x74, 0x72, 0x20, 0x22, 0x22, 0xa, 0x22, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x49, 0x64, 0x2d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a}, nil, nil}}},
-	{130, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x62, 0x61, 0x73, 0x65}, nil, nil}}}}}}},
-	{874, []*magicMatch{{0, 0, []byte{0x23, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x74, 0x68, 0x65, 0x2e, 0x66, 0x72, 0x65, 0x65, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72}, nil, nil}, {0, 0, []byte{0x23, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x72, 0x65, 0x65, 0x20, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x20, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x20, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72}, nil, nil}}},
-	{850, []*magicMatch{{0, 0, []byte{0x25, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x4a, 0x61, 0x62, 0x52, 0x65, 0x66}, nil, nil}}},
-	{453, []*magicMatch{{0, 0, []byte{0x2a, 0x2a, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x53, 0x51, 0x4c, 0x69, 0x74, 0x65}, nil, nil}}},
-	{388, []*magicMatch{{0, 32, []byte{0x3c, 0x6e, 0x61, 0x75, 0x74, 0x69, 0x6c, 0x75, 0x73, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x6e, 0x61, 0x75, 0x74, 0x69, 0x6c, 0x75, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x6b}, nil, nil}}},
-	{391, []*magicMatch{{35, 0, []byte{0x0}, nil, []*magicMatch{{0, 0, []byte{0x43, 0x4f, 0x50, 0x59, 0x52, 0x49, 0x47, 0x48, 0x54, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}, {0, 0, []byte{0x20, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x44, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}}}}},
-	{390, []*magicMatch{{35, 0, []byte{0x10}, nil, []*magicMatch{{0, 0, []byte{0x43, 0x4f, 0x50, 0x59, 0x52, 0x49, 0x47, 0x48, 0x54, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}, {0, 0, []byte{0x20, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x44, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}}}}},
-	{490, []*magicMatch{{0, 0, []byte{0x3c, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31, 0x22, 0x3e}, nil, []*magicMatch{{23, 1, []byte{0x3c, 0x56, 0x4d, 0x54, 0x65, 0x61, 0x6d, 0x3e}, nil, nil}}}}},
-	{377, []*magicMatch{{0, 0, []byte{0x5b, 0x4d, 0x4b, 0x56, 0x54, 0x6f, 0x6f, 0x6c, 0x4e, 0x69, 0x78, 0x25, 0x32, 0x30, 0x47, 0x55, 0x49, 0x25, 0x32, 0x30, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5d}, nil, nil}}},
-	{21, []*magicMatch{{11, 0, []byte{0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x42, 0x69, 0x6e, 0x48, 0x65, 0x78}, nil, nil}}},
-	{103, []*magicMatch{{2080, 0, []byte{0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x20, 0x45, 0x78, 0x63, 0x65, 0x6c, 0x20, 0x35, 0x2e, 0x30, 0x20, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}},
-	{38, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{36, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
-	{493, []*magicMatch{{0, 0, []byte{0x3c, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31, 0x22, 0x3e}, nil, []*magicMatch{{23, 1, []byte{0x3c, 0x56, 0x4d, 0x3e}, nil, nil}}}}},
-	{275, []*magicMatch{{260, 0, []byte{0xce, 0xed, 0x66, 0x66, 0xcc, 0xd, 0x0, 0xb, 0x3, 0x73, 0x0, 0x83, 0x0, 0xc, 0x0, 0xd, 0x0, 0x8, 0x11, 0x1f, 0x88, 0x89, 0x0, 0xe}, nil, []*magicMatch{{323, 0, []byte{0x0}, []byte{0x80}, nil}}}}},
-	{957, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6d, 0x6a, 0x70, 0x32}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
-	{609, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6a, 0x70, 0x6d, 0x20}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
-	{258, []*magicMatch{{0, 0, []byte{0x23, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x46, 0x6c, 0x74, 0x6b}, nil, nil}}},
-	{610, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6a, 0x70, 0x78, 0x20}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
-	{607, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6a, 0x70, 0x32, 0x20}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
-	{883, []*magicMatch{{0, 256, []byte{0x2f, 0x65, 0x74, 0x63, 0x2f, 0x73, 0x79, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73}, nil, nil}, {0, 256, []byte{0x2a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72}, nil, []*magicMatch{{0, 256, []byte{0x3a, 0x49, 0x4e, 0x50, 0x55, 0x54}, nil, []*magicMatch{{0, 256, []byte{0x3a, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44}, nil, []*magicMatch{{0, 256, []byte{0x3a, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54}, nil, nil}}}}}}}, {0, 256, []byte{0x2d, 0x41, 0x20, 0x49, 0x4e, 0x50, 0x55, 0x54}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x41, 0x20, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x41, 0x20, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54}, nil, nil}}}}}, {0, 256, []byte{0x2d, 0x50, 0x20, 0x49, 0x4e, 0x50, 0x55, 0x54}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x50, 0x20, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x50, 0x20, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54}, nil, nil}}}}}}},
-	{25, []*magicMatch{{0, 256, []byte{0x3c, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x33, 0x2e, 0x30, 0x22}, nil, nil}}},
-	{488, []*magicMatch{{0, 4096, []byte{0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6b, 0x65, 0x79, 0x53, 0x61, 0x66, 0x65, 0x20, 0x3d, 0x20, 0x22}, nil, nil}}},
-	{954, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x69, 0x73, 0x68, 0x65, 0x61, 0x64, 0x0}, nil, []*magicMatch{{56, 456, []byte{0x43, 0x4d, 0x4d, 0x4c, 0x0, 0x0, 0x0, 0x0}, nil, nil}}}}}}},
-	{539, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x69, 0x73, 0x68, 0x65, 0x61, 0x64, 0x0}, nil, []*magicMatch{{56, 456, []byte{0x43, 0x4d, 0x4d, 0x4c, 0x0, 0x0, 0x0, 0x0}, nil, nil}}}}}}},
-	{3, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x69, 0x73, 0x68, 0x65, 0x61, 0x64, 0x0}, nil, []*magicMatch{{56, 456, []byte{0x43, 0x4d, 0x4d, 0x4c, 0x0, 0x0, 0x0, 0x0}, nil, nil}}}}}}},
-	{532, []*magicMatch{{0, 64, []byte{0x3c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31}, nil, nil}, {0, 64, []byte{0x3c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x27, 0x31}, nil, nil}}},
-	{451, []*magicMatch{{40, 0, []byte{0x41, 0x53, 0x43, 0x49, 0x49, 0x20, 0x53, 0x50, 0x53, 0x53, 0x20, 0x50, 0x4f, 0x52, 0x54, 0x20, 0x46, 0x49, 0x4c, 0x45}, nil, nil}}},
-	{489, []*magicMatch{{0, 0, []byte{0x5c, 0x30, 0x78, 0x44, 0x30, 0x5c, 0x30, 0x78, 0x42, 0x45, 0x5c, 0x30, 0x78, 0x44, 0x30, 0x5c, 0x30, 0x78, 0x42, 0x45}, nil, nil}}},
-	{26, []*magicMatch{{0, 256, []byte{0x3c, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x3d, 0x22, 0x75, 0x72, 0x6e}, nil, nil}}},
-	{589, []*magicMatch{{0, 0, []byte{0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x20, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x3a}, nil, nil}}},
-	{274, []*magicMatch{{260, 0, []byte{0xce, 0xed, 0x66, 0x66, 0xcc, 0xd, 0x0, 0xb, 0x3, 0x73, 0x0, 0x83, 0x0, 0xc, 0x0, 0xd, 0x0, 0x8}, nil, []*magicMatch{{323, 0, []byte{0x80}, []byte{0x80}, nil}}}}},
-	{100, []*magicMatch{{0, 0, []byte{0x0, 0x1, 0x0, 0x0, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x20, 0x4a, 0x65, 0x74, 0x20, 0x44, 0x42}, nil, nil}}},
-	{201, []*magicMatch{{0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x53, 0x50, 0x52, 0x45, 0x41, 0x44, 0x53, 0x48, 0x45, 0x45, 0x54, 0x53}, nil, nil}, {0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e}, nil, []*magicMatch{{7, 0, []byte{0x53, 0x50, 0x52, 0x45, 0x41, 0x44, 0x53, 0x48, 0x45, 0x45, 0x54, 0x53}, nil, nil}}}}},
-	{491, []*magicMatch{{0, 4096, []byte{0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x20, 0x22}, nil, nil}}},
-	{298, []*magicMatch{{0, 0, []byte{0x48, 0x57, 0x50, 0x20, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x46, 0x69, 0x6c, 0x65}, nil, nil}}},
-	{861, []*magicMatch{{0, 256, []byte{0xa, 0x5b, 0x44, 0x2d, 0x42, 0x55, 0x53, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x44, 0x2d, 0x42, 0x55, 0x53, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}}},
-	{262, []*magicMatch{{0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x46, 0x6f, 0x6e, 0x74}, nil, nil}}},
-	{590, []*magicMatch{{0, 0, []byte{0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x20, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a}, nil, nil}}},
-	{284, []*magicMatch{{0, 256, []byte{0x3c, 0x67, 0x6c, 0x61, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65}, nil, nil}}},
-	{644, []*magicMatch{{0, 0, []byte{0x46, 0x55, 0x4a, 0x49, 0x46, 0x49, 0x4c, 0x4d, 0x43, 0x43, 0x44, 0x2d, 0x52, 0x41, 0x57, 0x20}, nil, nil}}},
-	{818, []*magicMatch{{0, 0, []byte{0x43, 0x41, 0x43, 0x48, 0x45, 0x20, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54}, nil, []*magicMatch{{14, 0, []byte{0x20}, nil, nil}, {14, 0, []byte{0x9}, nil, nil}, {14, 0, []byte{0xa}, nil, nil}, {14, 0, []byte{0xd}, nil, nil}}}}},
-	{384, []*magicMatch{{1, 0, []byte{0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74}, nil, nil}, {1, 0, []byte{0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54}, nil, []*magicMatch{{11, 0, []byte{0x42, 0x41, 0x53, 0x45, 0x55, 0x52, 0x4c, 0x3d}, nil, nil}}}}},
-	{432, []*magicMatch{{0, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x53, 0x45, 0x47, 0x41, 0x53, 0x41, 0x54, 0x55, 0x52, 0x4e}, nil, nil}, {16, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x53, 0x45, 0x47, 0x41, 0x53, 0x41, 0x54, 0x55, 0x52, 0x4e}, nil, nil}}},
-	{229, []*magicMatch{{0, 0, []byte{0x5b, 0x6d, 0x61, 0x69, 0x6e, 0x5d}, nil, []*magicMatch{{0, 256, []byte{0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x3d}, nil, nil}}}}},
-	{891, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x6b, 0x65}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x6b, 0x65}, nil, nil}}},
-	{819, []*magicMatch{{0, 0, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x3a, 0x56, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52}, nil, nil}, {0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x3a, 0x76, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72}, nil, nil}}},
-	{531, []*magicMatch{{0, 256, []byte{0x3c, 0x78, 0x73, 0x6c, 0x3a, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}},
-	{162, []*magicMatch{{0, 0, []byte{0x53, 0x51, 0x4c, 0x69, 0x74, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x33}, nil, nil}}},
-	{600, []*magicMatch{{0, 0, []byte{0x1, 0x0, 0x0, 0x0}, nil, []*magicMatch{{40, 0, []byte{0x20, 0x45, 0x4d, 0x46}, nil, []*magicMatch{{44, 0, []byte{0x0, 0x0, 0x1, 0x0}, nil, []*magicMatch{{58, 0, []byte{0x0, 0x0}, nil, nil}}}}}}}}},
-	{374, []*magicMatch{{0, 0, []byte{0x1a, 0x45, 0xdf, 0xa3}, nil, []*magicMatch{{5, 60, []byte{0x42, 0x82}, nil, []*magicMatch{{8, 67, []byte{0x6d, 0x61, 0x74, 0x72, 0x6f, 0x73, 0x6b, 0x61}, nil, nil}}}}}}},
-	{632, []*magicMatch{{0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e}, nil, []*magicMatch{{7, 0, []byte{0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x53}, nil, nil}}}}},
-	{307, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x61, 0x70, 0x78, 0x6c}, nil, nil}}}}},
-	{560, []*magicMatch{{4, 0, []byte{0x69, 0x72, 0x69, 0x76, 0x65, 0x72, 0x20, 0x55, 0x4d, 0x53, 0x20, 0x50, 0x4c, 0x41}, nil, nil}}},
-	{97, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x2, 0x0, 0x6, 0x4, 0x6, 0x0, 0x8, 0x0, 0x0, 0x0, 0x0, 0x0}, nil, nil}}},
-	{516, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x78, 0x62, 0x65, 0x6c}, nil, nil}}},
-	{29, []*magicMatch{{0, 256, []byte{0x6, 0xe, 0x2b, 0x34, 0x2, 0x5, 0x1, 0x1, 0xd, 0x1, 0x2, 0x1, 0x1, 0x2}, nil, nil}}},
-	{256, []*magicMatch{{1, 0, []byte{0x2a, 0x4e, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x44, 0x4f, 0x2d, 0x48, 0x56, 0x43, 0x2a}, nil, nil}}},
-	{81, []*magicMatch{{0, 256, []byte{0x5b, 0x46, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x20, 0x52, 0x65, 0x70, 0x6f, 0x5d}, nil, nil}}},
-	{635, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x1a, 0x0, 0x0, 0x0, 0x48, 0x45, 0x41, 0x50, 0x43, 0x43, 0x44, 0x52}, nil, nil}}},
-	{913, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}}},
-	{243, []*magicMatch{{0, 32, []byte{0x5b, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x20, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x5d}, nil, nil}, {0, 0, []byte{0x5b, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x5b, 0x4b, 0x44, 0x45, 0x20, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x20, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x5d}, nil, nil}, {0, 0, []byte{0x23, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x23, 0x20, 0x4b, 0x44, 0x45, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x46, 0x69, 0x6c, 0x65}, nil, nil}}},
-	{77, []*magicMatch{{0, 0, []byte{0x21, 0x3c, 0x61, 0x72, 0x63, 0x68, 0x3e}, nil, []*magicMatch{{8, 0, []byte{0x64, 0x65, 0x62, 0x69, 0x61, 0x6e}, nil, nil}}}}},
-	{928, []*magicMatch{{0, 0, []byte{0x5b, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5d}, nil, nil}}},
-	{933, []*magicMatch{{1, 0, []byte{0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73}, nil, nil}}},
-	{879, []*magicMatch{{0, 0, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x3a, 0x49, 0x4d, 0x45, 0x4c, 0x4f, 0x44, 0x59}, nil, nil}}},
-	{80, []*magicMatch{{0, 256, []byte{0x5b, 0x46, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x20, 0x52, 0x65, 0x66, 0x5d}, nil, nil}}},
-	{79, []*magicMatch{{0, 0, []byte{0x78, 0x64, 0x67, 0x2d, 0x61, 0x70, 0x70, 0x0, 0x1, 0x0, 0x89, 0xe5}, nil, nil}, {0, 0, []byte{0x66, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x0, 0x1, 0x0, 0x89, 0xe5}, nil, nil}}},
-	{827, []*magicMatch{{0, 0, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x54, 0x65, 0x58, 0x2c}, nil, nil}, {0, 0, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x4d, 0x45, 0x54, 0x41, 0x46, 0x4f, 0x4e, 0x54, 0x2c}, nil, nil}}},
-	{286, []*magicMatch{{0, 64, []byte{0x67, 0x6d, 0x72, 0x3a, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b}, nil, nil}, {0, 64, []byte{0x67, 0x6e, 0x6d, 0x3a, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b}, nil, nil}}},
-	{82, []*magicMatch{{0, 0, []byte{0x53, 0x70, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x44, 0x42}, nil, nil}}},
-	{836, []*magicMatch{{0, 0, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x3a, 0x56, 0x43, 0x41, 0x52, 0x44}, nil, nil}, {0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x3a, 0x76, 0x63, 0x61, 0x72, 0x64}, nil, nil}}},
-	{202, []*magicMatch{{0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e}, nil, []*magicMatch{{7, 0, []byte{0x57, 0x4f, 0x52, 0x44, 0x53}, nil, nil}}}}},
-	{213, []*magicMatch{{0, 0, []byte{0x64, 0x38, 0x3a, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65}, nil, nil}}},
-	{433, []*magicMatch{{38, 0, []byte{0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}},
-	{6, []*magicMatch{{0, 0, []byte{0x78, 0x62, 0x74, 0x6f, 0x61, 0x20, 0x42, 0x65, 0x67, 0x69, 0x6e}, nil, nil}}},
-	{211, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}}},
-	{16, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x67, 0x6a, 0x73}, nil, nil}}},
-	{968, []*magicMatch{{0, 0, []byte{0x1a, 0x45, 0xdf, 0xa3}, nil, []*magicMatch{{5, 60, []byte{0x42, 0x82}, nil, []*magicMatch{{8, 67, []byte{0x77, 0x65, 0x62, 0x6d}, nil, nil}}}}}}},
-	{579, []*magicMatch{{0, 0, []byte{0x5b, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5d}, nil, nil}, {0, 0, []byte{0x5b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5d}, nil, nil}, {0, 0, []byte{0x5b, 0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x5d}, nil, nil}}},
-	{927, []*magicMatch{{0, 256, []byte{0x5b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x5d}, nil, nil}, {0, 256, []byte{0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x3a, 0x20}, nil, nil}}},
-	{691, []*magicMatch{{0, 0, []byte{0x67, 0x69, 0x6d, 0x70, 0x20, 0x78, 0x63, 0x66, 0x20, 0x66, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x67, 0x69, 0x6d, 0x70, 0x20, 0x78, 0x63, 0x66, 0x20, 0x76}, nil, nil}}},
-	{290, []*magicMatch{{0, 256, []byte{0x3c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65}, nil, nil}}},
-	{260, []*magicMatch{{0, 0, []byte{0x53, 0x54, 0x41, 0x52, 0x54, 0x46, 0x4f, 0x4e, 0x54, 0x20}, nil, nil}}},
-	{618, []*magicMatch{{0, 0, []byte{0x38, 0x42, 0x50, 0x53, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, []byte{0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
-	{582, []*magicMatch{{20, 0, []byte{0x21, 0x53, 0x63, 0x72, 0x65, 0x61, 0x6d, 0x21, 0x1a}, nil, nil}, {20, 0, []byte{0x21, 0x53, 0x43, 0x52, 0x45, 0x41, 0x4d, 0x21, 0x1a}, nil, nil}, {20, 0, []byte{0x42, 0x4d, 0x4f, 0x44, 0x32, 0x53, 0x54, 0x4d, 0x1a}, nil, nil}}},
-	{806, []*magicMatch{{72, 0, []byte{0x53, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x31, 0xa}, nil, nil}, {72, 0, []byte{0x53, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0xa}, nil, nil}}},
-	{536, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0x2d, 0x57, 0x42, 0xa}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0x2d, 0x57, 0x42, 0x5f, 0x4d, 0x43, 0x31, 0x2e, 0x30, 0xa}, nil, nil}}},
-	{304, []*magicMatch{{0, 0, []byte{0x7b}, nil, []*magicMatch{{1, 255, []byte{0x22, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x3a}, nil, nil}}}}},
-	{430, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x72, 0x75, 0x62, 0x79}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x72, 0x75, 0x62, 0x79}, nil, nil}}},
-	{602, []*magicMatch{{0, 0, []byte{0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x20, 0x20, 0x3d}, nil, nil}}},
-	{435, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x50, 0x49, 0x43, 0x4f}, nil, nil}}},
-	{33, []*magicMatch{{0, 256, []byte{0x3c, 0x4f, 0x6e, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x79}, nil, nil}}},
-	{394, []*magicMatch{{0, 0, []byte{0x5b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5d}, nil, nil}}},
-	{291, []*magicMatch{{4, 0, []byte{0x67, 0x74, 0x6b, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x20}, nil, nil}}},
-	{208, []*magicMatch{{1, 0, []byte{0x41, 0x54, 0x41, 0x52, 0x49, 0x37, 0x38, 0x30, 0x30}, nil, nil}}},
-	{553, []*magicMatch{{0, 0, []byte{0x2e, 0x73, 0x6e, 0x64}, nil, []*magicMatch{{12, 0, []byte{0x0, 0x0, 0x0, 0x17}, nil, nil}}}, {0, 0, []byte{0x2e, 0x73, 0x64, 0x0}, nil, []*magicMatch{{12, 0, []byte{0x1, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x2, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x3, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x4, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x5, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x6, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x7, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x17, 0x0, 0x0, 0x0}, nil, nil}}}}},
-	{960, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x69, 0x73, 0x6f, 0x6d}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x6d, 0x70, 0x34, 0x32}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x53, 0x4e, 0x56}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x34, 0x56, 0x20}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x66, 0x34, 0x76, 0x20}, nil, nil}}},
-	{237, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x63, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x63, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x74, 0x63, 0x73, 0x68}, nil, nil}}},
-	{979, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41, 0x56, 0x49, 0x20}, nil, nil}}}, {0, 0, []byte{0x41, 0x56, 0x46, 0x30}, nil, []*magicMatch{{8, 0, []byte{0x41, 0x56, 0x49, 0x20}, nil, nil}}}}},
-	{671, []*magicMatch{{522, 0, []byte{0x0, 0x11}, nil, []*magicMatch{{524, 0, []byte{0x2, 0xff}, nil, []*magicMatch{{526, 0, []byte{0xc, 0x0}, nil, []*magicMatch{{528, 0, []byte{0xff, 0xfe}, nil, nil}}}}}}}}},
-	{890, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6c, 0x75, 0x61}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6c, 0x75, 0x61, 0x6a, 0x69, 0x74}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x6c, 0x75, 0x61}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x6c, 0x75, 0x61, 0x6a, 0x69, 0x74}, nil, nil}}},
-	{671, []*magicMatch{{10, 0, []byte{0x0, 0x11}, nil, []*magicMatch{{12, 0, []byte{0x2, 0xff}, nil, []*magicMatch{{14, 0, []byte{0xc, 0x0}, nil, []*magicMatch{{16, 0, []byte{0xff, 0xfe}, nil, nil}}}}}}}}},
-	{199, []*magicMatch{{60, 0, []byte{0x54, 0x45, 0x58, 0x74, 0x52, 0x45, 0x41, 0x64}, nil, nil}, {60, 0, []byte{0x54, 0x45, 0x58, 0x74, 0x54, 0x6c, 0x44, 0x63}, nil, nil}}},
-	{622, []*magicMatch{{0, 64, []byte{0xa, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0xa}, nil, nil}, {0, 64, []byte{0xd, 0xa, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0xd, 0xa}, nil, nil}}},
-	{192, []*magicMatch{{0, 256, []byte{0x3c, 0x61, 0x62, 0x69, 0x77, 0x6f, 0x72, 0x64}, nil, nil}, {0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x61, 0x62, 0x69, 0x77, 0x6f, 0x72, 0x64}, nil, nil}}},
-	{679, []*magicMatch{{0, 0, []byte{0x46, 0x4f, 0x56, 0x62}, nil, []*magicMatch{{4, 0, []byte{0xff, 0x0, 0xff, 0x0}, []byte{0x0, 0xff, 0x0, 0xff}, nil}}}}},
-	{215, []*magicMatch{{0, 0, []byte{0x42, 0x53, 0x44, 0x49, 0x46, 0x46, 0x34, 0x30}, nil, nil}, {0, 0, []byte{0x42, 0x53, 0x44, 0x49, 0x46, 0x4e, 0x34, 0x30}, nil, nil}}},
-	{628, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x57, 0x45, 0x42, 0x50}, nil, nil}}}}},
-	{918, []*magicMatch{{0, 0, []byte{0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x3a, 0x20}, nil, nil}, {0, 0, []byte{0x25, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x20}, nil, nil}}},
-	{389, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41, 0x43, 0x4f, 0x4e}, nil, nil}}}}},
-	{666, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x55, 0x0, 0x8, 0x0, 0x0, 0x0}, nil, nil}}},
-	{382, []*magicMatch{{0, 0, []byte{0x4d, 0x53, 0x57, 0x49, 0x4d, 0x0, 0x0, 0x0}, nil, nil}}},
-	{892, []*magicMatch{{0, 0, []byte{0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}}},
-	{976, []*magicMatch{{0, 0, []byte{0x8a, 0x4d, 0x4e, 0x47, 0xd, 0xa, 0x1a, 0xa}, nil, nil}}},
-	{665, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x52, 0x4f, 0x8, 0x0, 0x0, 0x0}, nil, nil}}},
-	{680, []*magicMatch{{0, 0, []byte{0x23, 0x23, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68}, nil, nil}}},
-	{667, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x55, 0x0, 0x18, 0x0, 0x0, 0x0}, nil, nil}}},
-	{297, []*magicMatch{{0, 0, []byte{0x48, 0x58, 0x43, 0x50, 0x49, 0x43, 0x46, 0x45}, nil, nil}}},
-	{12, []*magicMatch{{0, 0, []byte{0x89, 0x47, 0x4e, 0x44, 0xd, 0xa, 0x1a, 0xa}, nil, nil}}},
-	{281, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x33, 0x32, 0x58}, nil, nil}}},
-	{897, []*magicMatch{{0, 0, []byte{0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}}},
-	{931, []*magicMatch{{0, 256, []byte{0xa, 0x5b, 0x55, 0x6e, 0x69, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x50, 0x61, 0x74, 0x68, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x77, 0x61, 0x70, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x55, 0x6e, 0x69, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x50, 0x61, 0x74, 0x68, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x77, 0x61, 0x70, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x5d, 0xa}, nil, nil}}},
-	{440, []*magicMatch{{10, 0, []byte{0x23, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6e, 0x61, 0x77, 0x6b}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x7a, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6b, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x64, 0x61, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x62, 0x61, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x7a, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x6b, 0x73, 0x68}, nil, nil}}},
-	{409, []*magicMatch{{0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x65, 0x72, 0x6c}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x65, 0x72, 0x6c}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x70, 0x65, 0x72, 0x6c}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x54, 0x65, 0x73, 0x74, 0x3a, 0x3a}, nil, nil}, {0, 256, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x7b}, nil, nil}}},
-	{397, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x1, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x1}, nil, nil}}}}}}},
-	{952, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x65}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x67}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x70}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x73}, nil, nil}}},
-	{901, []*magicMatch{{0, 0, []byte{0x52, 0x45, 0x47, 0x45, 0x44, 0x49, 0x54}, nil, nil}, {0, 0, []byte{0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x20, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x20, 0x45, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x35, 0x2e, 0x30, 0x30}, nil, nil}, {0, 0, []byte{0xff, 0xfe, 0x57, 0x0, 0x69, 0x0, 0x6e, 0x0, 0x64, 0x0, 0x6f, 0x0, 0x77, 0x0, 0x73, 0x0, 0x20, 0x0, 0x52, 0x0, 0x65, 0x0, 0x67, 0x0, 0x69, 0x0, 0x73, 0x0, 0x74, 0x0, 0x72, 0x0, 0x79, 0x0, 0x20, 0x0, 0x45, 0x0, 0x64, 0x0, 0x69, 0x0, 0x74, 0x0, 0x6f, 0x0, 0x72, 0x0}, nil, nil}}},
-	{288, []*magicMatch{{0, 0, []byte{0x28, 0x3b, 0x46, 0x46, 0x5b, 0x33, 0x5d}, nil, nil}, {0, 0, []byte{0x28, 0x3b, 0x46, 0x46, 0x5b, 0x34, 0x5d}, nil, nil}}},
-	{886, []*magicMatch{{0, 0, []byte{0x64, 0x6e, 0x3a, 0x20, 0x63, 0x6e, 0x3d}, nil, nil}, {0, 0, []byte{0x64, 0x6e, 0x3a, 0x20, 0x6d, 0x61, 0x69, 0x6c, 0x3d}, nil, nil}}},
-	{544, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x34, 0x41}, nil, nil}}},
-	{899, []*magicMatch{{0, 256, []byte{0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x3d}, nil, nil}}},
-	{953, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x32}, nil, nil}}},
-	{214, []*magicMatch{{0, 0, []byte{0x42, 0x4c, 0x45, 0x4e, 0x44, 0x45, 0x52}, nil, nil}}},
-	{303, []*magicMatch{{0, 0, []byte{0x53, 0x20, 0x54, 0x20, 0x4f, 0x20, 0x50}, nil, nil}}},
-	{841, []*magicMatch{{0, 0, []byte{0x4d, 0x49, 0x44, 0x6c, 0x65, 0x74, 0x2d}, nil, nil}}},
-	{78, []*magicMatch{{0, 0, []byte{0x6e, 0x46, 0x37, 0x59, 0x4c, 0x61, 0x6f}, nil, nil}}},
-	{964, []*magicMatch{{0, 0, []byte{0x23, 0x45, 0x58, 0x54, 0x4d, 0x34, 0x55}, nil, nil}}},
-	{98, []*magicMatch{{0, 0, []byte{0x57, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f}, nil, nil}}},
-	{568, []*magicMatch{{0, 0, []byte{0x23, 0x45, 0x58, 0x54, 0x4d, 0x33, 0x55}, nil, nil}}},
-	{57, []*magicMatch{{0, 0, []byte{0x23, 0x25, 0x52, 0x41, 0x4d, 0x4c, 0x20}, nil, nil}}},
-	{419, []*magicMatch{{0, 0, []byte{0x51, 0x74, 0x69, 0x50, 0x6c, 0x6f, 0x74}, nil, nil}}},
-	{562, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x34, 0x42}, nil, nil}}},
-	{72, []*magicMatch{{0, 0, []byte{0x5b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x20}, nil, nil}}},
-	{228, []*magicMatch{{0, 0, []byte{0x43, 0x44, 0x5f, 0x52, 0x4f, 0x4d, 0xa}, nil, nil}, {0, 0, []byte{0x43, 0x44, 0x5f, 0x44, 0x41, 0xa}, nil, nil}, {0, 0, []byte{0x43, 0x44, 0x5f, 0x52, 0x4f, 0x4d, 0x5f, 0x58, 0x41, 0xa}, nil, nil}, {0, 0, []byte{0x43, 0x44, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x20}, nil, nil}, {0, 0, []byte{0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x20, 0x22}, nil, []*magicMatch{{22, 0, []byte{0x22}, nil, nil}}}}},
-	{305, []*magicMatch{{1, 0, []byte{0x45, 0x4c, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41}, nil, []*magicMatch{{9, 0, []byte{0x49}, nil, []*magicMatch{{10, 0, []byte{0x1}, nil, nil}}}}}}}}},
-	{838, []*magicMatch{{0, 0, []byte{0x64, 0x69, 0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}, {0, 0, []byte{0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x64, 0x69, 0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}, {0, 0, []byte{0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}, {0, 0, []byte{0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}}},
-	{70, []*magicMatch{{1, 0, []byte{0x45, 0x4c, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41}, nil, []*magicMatch{{9, 0, []byte{0x49}, nil, []*magicMatch{{10, 0, []byte{0x2}, nil, nil}}}}}}}}},
-	{348, []*magicMatch{{4, 0, []byte{0x4b, 0x53, 0x79, 0x73, 0x56}, nil, []*magicMatch{{15, 0, []byte{0x1}, nil, nil}}}}},
-	{455, []*magicMatch{{0, 0, []byte{0x31}, nil, []*magicMatch{{0, 256, []byte{0x20, 0x2d, 0x2d, 0x3e, 0x20}, nil, nil}}}}},
-	{535, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0xa}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0x5f, 0x4d, 0x43, 0x31, 0x2e, 0x30, 0xa}, nil, nil}}},
-	{900, []*magicMatch{{0, 0, []byte{0x3c, 0x6d, 0x72, 0x6d, 0x6c, 0x20}, nil, nil}}},
-	{942, []*magicMatch{{0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x20}, nil, nil}}},
-	{844, []*magicMatch{{0, 0, []byte{0x57, 0x45, 0x42, 0x56, 0x54, 0x54}, nil, nil}}},
-	{431, []*magicMatch{{0, 256, []byte{0x3c, 0x53, 0x41, 0x4d, 0x49, 0x3e}, nil, nil}}},
-	{897, []*magicMatch{{0, 0, []byte{0x72, 0x65, 0x63, 0x6f, 0x72, 0x64}, nil, nil}}},
-	{694, []*magicMatch{{0, 0, []byte{0x2f, 0x2a, 0x20, 0x58, 0x50, 0x4d}, nil, nil}}},
-	{808, []*magicMatch{{0, 0, []byte{0x23, 0x56, 0x52, 0x4d, 0x4c, 0x20}, nil, nil}}},
-	{245, []*magicMatch{{5, 95, []byte{0x3c, 0x73, 0x68, 0x61, 0x70, 0x65}, nil, nil}}},
-	{685, []*magicMatch{{0, 0, []byte{0x56, 0x43, 0x4c, 0x4d, 0x54, 0x46}, nil, nil}}},
-	{280, []*magicMatch{{0, 0, []byte{0x30, 0x20, 0x48, 0x45, 0x41, 0x44}, nil, nil}}},
-	{902, []*magicMatch{{0, 0, []byte{0x2f, 0x2f, 0x21, 0x4d, 0x75, 0x70}, nil, nil}}},
-	{951, []*magicMatch{{0, 0, []byte{0x23, 0x20, 0x78, 0x6d, 0x63, 0x64}, nil, nil}}},
-	{804, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x20, 0x72, 0x6e, 0x65, 0x77, 0x73}, nil, nil}, {0, 0, []byte{0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x20, 0x74, 0x6f}, nil, nil}, {0, 0, []byte{0x46, 0x72, 0x6f, 0x6d, 0x3a}, nil, nil}, {0, 0, []byte{0x4e, 0x23, 0x21, 0x20, 0x72, 0x6e, 0x65, 0x77, 0x73}, nil, nil}, {0, 0, []byte{0x50, 0x69, 0x70, 0x65, 0x20, 0x74, 0x6f}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x3a}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x6c, 0x61, 0x79, 0x2d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x50, 0x61, 0x74, 0x68, 0x3a}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x3a}, nil, nil}, {0, 0, []byte{0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x3a, 0x20}, nil, nil}}},
-	{959, []*magicMatch{{0, 0, []byte{0x47}, nil, []*magicMatch{{188, 0, []byte{0x47}, nil, []*magicMatch{{376, 0, []byte{0x47}, nil, []*magicMatch{{564, 0, []byte{0x47}, nil, []*magicMatch{{752, 0, []byte{0x47}, nil, nil}}}}}}}}}, {4, 0, []byte{0x47}, nil, []*magicMatch{{196, 0, []byte{0x47}, nil, []*magicMatch{{388, 0, []byte{0x47}, nil, []*magicMatch{{580, 0, []byte{0x47}, nil, []*magicMatch{{772, 0, []byte{0x47}, nil, nil}}}}}}}}}}},
-	{234, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4}, []byte{0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff}, nil}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x4, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x4}, nil, nil}}}}}, {0, 0, []byte{0x43, 0x6f, 0x72, 0x65, 0x1}, nil, nil}, {0, 0, []byte{0x43, 0x6f, 0x72, 0x65, 0x2}, nil, nil}}},
-	{802, []*magicMatch{{0, 0, []byte{0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x50, 0x61, 0x74, 0x68, 0x3a}, nil, nil}, {0, 0, []byte{0x58, 0x72, 0x65, 0x66, 0x3a}, nil, nil}}},
-	{459, []*magicMatch{{0, 0, []byte{0x40, 0x43, 0x54, 0x20, 0x30}, nil, nil}, {0, 0, []byte{0x40, 0x43, 0x54, 0x20, 0x31}, nil, nil}, {0, 0, []byte{0x40, 0x43, 0x54, 0x20, 0x32}, nil, nil}}},
-	{623, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x1, 0x0}, nil, []*magicMatch{{5, 0, []byte{0x0}, nil, nil}}}}},
-	{689, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x2, 0x0}, nil, []*magicMatch{{5, 0, []byte{0x0}, nil, nil}}}}},
-	{942, []*magicMatch{{0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e}, nil, nil}, {0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x2d, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34}, nil, nil}}},
-	{807, []*magicMatch{{0, 0, []byte{0x73, 0x6f, 0x6c, 0x69, 0x64}, nil, nil}, {0, 0, []byte{0x53, 0x4f, 0x4c, 0x49, 0x44}, nil, nil}}},
-	{580, []*magicMatch{{0, 0, []byte{0x53, 0x70, 0x65, 0x65, 0x78}, nil, nil}}},
-	{306, []*magicMatch{{0, 0, []byte{0x49, 0x54, 0x38, 0x2e, 0x37}, nil, nil}}},
-	{267, []*magicMatch{{0, 0, []byte{0x44, 0x31, 0x2e, 0x30, 0xd}, nil, nil}}},
-	{897, []*magicMatch{{0, 0, []byte{0x6d, 0x6f, 0x64, 0x65, 0x6c}, nil, nil}}},
-	{35, []*magicMatch{{0, 1024, []byte{0x25, 0x50, 0x44, 0x46, 0x2d}, nil, nil}}},
-	{464, []*magicMatch{{0, 0, []byte{0x25, 0x54, 0x47, 0x49, 0x46}, nil, nil}}},
-	{244, []*magicMatch{{5, 95, []byte{0x3c, 0x64, 0x69, 0x61, 0x3a}, nil, nil}}},
-	{62, []*magicMatch{{0, 0, []byte{0x7b, 0x5c, 0x72, 0x74, 0x66}, nil, nil}}},
-	{414, []*magicMatch{{0, 0, []byte{0x7b, 0x5c, 0x70, 0x77, 0x69}, nil, nil}}},
-	{521, []*magicMatch{{0, 0, []byte{0x25, 0x59, 0x41, 0x4d, 0x4c}, nil, nil}}},
-	{312, []*magicMatch{{0, 256, []byte{0x3c, 0x6a, 0x6e, 0x6c, 0x70}, nil, nil}}},
-	{847, []*magicMatch{{0, 0, []byte{0x24, 0x6c, 0x74, 0x3b, 0x7e}, nil, nil}}},
-	{949, []*magicMatch{{0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e}, nil, nil}}},
-	{897, []*magicMatch{{0, 0, []byte{0x63, 0x6c, 0x61, 0x73, 0x73}, nil, nil}}},
-	{912, []*magicMatch{{0, 0, []byte{0x64, 0x69, 0x66, 0x66, 0x9}, nil, nil}, {0, 0, []byte{0x64, 0x69, 0x66, 0x66, 0x20}, nil, nil}, {0, 0, []byte{0x2a, 0x2a, 0x2a, 0x9}, nil, nil}, {0, 0, []byte{0x2a, 0x2a, 0x2a, 0x20}, nil, nil}, {0, 0, []byte{0x3d, 0x3d, 0x3d, 0x20}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x20}, nil, nil}, {0, 0, []byte{0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x69, 0x6e, 0x9}, nil, nil}, {0, 0, []byte{0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x69, 0x6e, 0x20}, nil, nil}, {0, 0, []byte{0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x20, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x20}, nil, nil}, {0, 0, []byte{0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a}, nil, nil}}},
-	{439, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x3, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x3}, nil, nil}}}}}, {0, 0, []byte{0x83, 0x1}, nil, []*magicMatch{{22, 0, []byte{0x0, 0x20}, []byte{0x0, 0x30}, nil}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, []byte{0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff}, nil}}},
-	{83, []*magicMatch{{0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x49, 0x46, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x46, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x4d, 0x4c}, nil, nil}, {0, 0, []byte{0x3c, 0x42, 0x6f, 0x6f, 0x6b}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72}, nil, nil}}},
-	{629, []*magicMatch{{0, 0, []byte{0xd7, 0xcd, 0xc6, 0x9a}, nil, []*magicMatch{{22, 0, []byte{0x1, 0x0}, nil, []*magicMatch{{24, 0, []byte{0x9, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x1, 0x0}, nil, []*magicMatch{{2, 0, []byte{0x9, 0x0}, nil, nil}}}}},
-	{282, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53}, nil, nil}, {256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x4d, 0x45, 0x47, 0x41, 0x20, 0x44, 0x52, 0x49, 0x56, 0x45}, nil, nil}, {640, 0, []byte{0x45, 0x41, 0x47, 0x4e}, nil, nil}, {640, 0, []byte{0x45, 0x41, 0x4d, 0x47}, nil, nil}}},
-	{549, []*magicMatch{{0, 0, []byte{0x7f, 0xfe, 0x80, 0x1}, nil, nil}, {0, 0, []byte{0x80, 0x1, 0x7f, 0xfe}, nil, nil}, {0, 0, []byte{0x1f, 0xff, 0xe8, 0x0}, nil, nil}, {0, 0, []byte{0xe8, 0x0, 0x1f, 0xff}, nil, nil}}},
-	{963, []*magicMatch{{12, 0, []byte{0x6d, 0x64, 0x61, 0x74}, nil, nil}, {4, 0, []byte{0x6d, 0x64, 0x61, 0x74}, nil, nil}, {4, 0, []byte{0x6d, 0x6f, 0x6f, 0x76}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x71, 0x74}, nil, nil}}},
-	{961, []*magicMatch{{0, 0, []byte{0x47, 0x3f, 0xff, 0x10}, nil, nil}, {0, 0, []byte{0x0, 0x0, 0x1, 0xb3}, nil, nil}, {0, 0, []byte{0x0, 0x0, 0x1, 0xba}, nil, nil}}},
-	{261, []*magicMatch{{0, 0, []byte{0xff, 0x46, 0x4f, 0x4e}, nil, nil}, {7, 0, []byte{0x0, 0x45, 0x47, 0x41}, nil, nil}, {7, 0, []byte{0x0, 0x56, 0x49, 0x44}, nil, nil}}},
-	{279, []*magicMatch{{0, 0, []byte{0x13, 0x57, 0x9a, 0xce}, nil, nil}, {0, 0, []byte{0xce, 0x9a, 0x57, 0x13}, nil, nil}, {0, 0, []byte{0x47, 0x44, 0x42, 0x4d}, nil, nil}}},
-	{594, []*magicMatch{{0, 0, []byte{0x46, 0x46, 0x49, 0x4c}, nil, nil}, {65, 0, []byte{0x46, 0x46, 0x49, 0x4c}, nil, nil}, {0, 0, []byte{0x0, 0x1, 0x0, 0x0, 0x0}, nil, nil}}},
-	{387, []*magicMatch{{0, 0, []byte{0x80, 0x37, 0x12, 0x40}, nil, nil}, {0, 0, []byte{0x37, 0x80, 0x40, 0x12}, nil, nil}, {0, 0, []byte{0x40, 0x12, 0x37, 0x80}, nil, nil}}},
-	{507, []*magicMatch{{24, 0, []byte{0x5d, 0x1c, 0x9e, 0xa3}, nil, nil}, {0, 0, []byte{0x57, 0x42, 0x46, 0x53}, nil, nil}, {0, 0, []byte{0x57, 0x49, 0x49, 0x1, 0x44, 0x49, 0x53, 0x43}, nil, nil}}},
-	{508, []*magicMatch{{4, 0, []byte{0x49, 0x73, 0x0, 0x0}, nil, nil}, {4, 0, []byte{0x69, 0x62, 0x0, 0x0}, nil, nil}, {4, 0, []byte{0x42, 0x6b, 0x0, 0x0}, nil, nil}}},
-	{398, []*magicMatch{{0, 0, []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}, nil, nil}, {0, 0, []byte{0xd0, 0xcf, 0x11, 0xe0}, nil, nil}}},
-	{283, []*magicMatch{{0, 0, []byte{0xde, 0x12, 0x4, 0x95}, nil, nil}, {0, 0, []byte{0x95, 0x4, 0x12, 0xde}, nil, nil}}},
-	{651, []*magicMatch{{8, 0, []byte{0x49, 0x4c, 0x42, 0x4d}, nil, nil}, {8, 0, []byte{0x50, 0x42, 0x4d, 0x20}, nil, nil}}},
-	{591, []*magicMatch{{0, 0, []byte{0x58, 0x4d, 0x46, 0x5f}, nil, nil}, {0, 0, []byte{0x58, 0x4d, 0x46, 0x5f, 0x32, 0x2e, 0x30, 0x30, 0x0, 0x0, 0x0, 0x2}, nil, nil}}},
-	{617, []*magicMatch{{0, 0, []byte{0x4d, 0x4d, 0x0, 0x2a}, nil, nil}, {0, 0, []byte{0x49, 0x49, 0x2a, 0x0}, nil, nil}}},
-	{586, []*magicMatch{{8, 0, []byte{0x57, 0x41, 0x56, 0x45}, nil, nil}, {8, 0, []byte{0x57, 0x41, 0x56, 0x20}, nil, nil}}},
-	{182, []*magicMatch{{0, 0, []byte{0xa1, 0xb2, 0xc3, 0xd4}, nil, nil}, {0, 0, []byte{0xd4, 0xc3, 0xb2, 0xa1}, nil, nil}}},
-	{101, []*magicMatch{{0, 0, []byte{0x30, 0x26, 0xb2, 0x75}, nil, nil}, {0, 0, []byte{0x5b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5d}, nil, nil}}},
-	{452, []*magicMatch{{0, 0, []byte{0x24, 0x46, 0x4c, 0x32}, nil, nil}, {0, 0, []byte{0x24, 0x46, 0x4c, 0x33}, nil, nil}}},
-	{573, []*magicMatch{{4, 0, []byte{0x57, 0x90, 0x75, 0x36}, nil, nil}, {8, 0, []byte{0x61, 0x61, 0x78, 0x20}, nil, nil}}},
-	{63, []*magicMatch{{0, 0, []byte{0x76, 0x3d}, nil, []*magicMatch{{0, 256, []byte{0x73, 0x3d}, nil, nil}}}}},
-	{555, []*magicMatch{{8, 0, []byte{0x41, 0x49, 0x46, 0x46}, nil, nil}, {8, 0, []byte{0x38, 0x53, 0x56, 0x58}, nil, nil}}},
-	{248, []*magicMatch{{0, 0, []byte{0x49, 0x57, 0x41, 0x44}, nil, nil}, {0, 0, []byte{0x50, 0x57, 0x41, 0x44}, nil, nil}}},
-	{242, []*magicMatch{{0, 256, []byte{0x3c, 0x75, 0x69, 0x20}, nil, nil}, {0, 256, []byte{0x3c, 0x55, 0x49, 0x20}, nil, nil}}},
-	{163, []*magicMatch{{0, 0, []byte{0x73, 0x71, 0x73, 0x68}, nil, nil}, {0, 0, []byte{0x68, 0x73, 0x71, 0x73}, nil, nil}}},
-	{296, []*magicMatch{{0, 0, []byte{0x89, 0x48, 0x44, 0x46, 0xd, 0xa, 0x1a, 0xa}, nil, nil}, {0, 0, []byte{0xe, 0x3, 0x13, 0x1}, nil, nil}}},
-	{492, []*magicMatch{{0, 0, []byte{0x23, 0x20, 0x44, 0x69, 0x73, 0x6b, 0x20, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x4b, 0x44, 0x4d, 0x56}, nil, nil}}},
-	{541, []*magicMatch{{0, 0, []byte{0x66, 0x4c, 0x61, 0x43}, nil, nil}}},
-	{160, []*magicMatch{{0, 0, []byte{0x2e, 0x52, 0x4d, 0x46}, nil, nil}}},
-	{642, []*magicMatch{{0, 0, []byte{0x76, 0x2f, 0x31, 0x1}, nil, nil}}},
-	{643, []*magicMatch{{0, 0, []byte{0x46, 0x50, 0x69, 0x78}, nil, nil}}},
-	{116, []*magicMatch{{0, 0, []byte{0x78, 0x9f, 0x3e, 0x22}, nil, nil}}},
-	{645, []*magicMatch{{20, 0, []byte{0x47, 0x49, 0x4d, 0x50}, nil, nil}}},
-	{647, []*magicMatch{{20, 0, []byte{0x47, 0x50, 0x41, 0x54}, nil, nil}}},
-	{650, []*magicMatch{{0, 0, []byte{0x69, 0x63, 0x6e, 0x73}, nil, nil}}},
-	{346, []*magicMatch{{0, 0, []byte{0xd, 0x1a, 0x27, 0x2}, nil, nil}}},
-	{653, []*magicMatch{{0, 0, []byte{0xff, 0x4f, 0xff, 0x51}, nil, nil}}},
-	{1002, []*magicMatch{{0, 0, []byte{0x7a, 0x1a, 0x20, 0x10}, nil, nil}}},
-	{661, []*magicMatch{{0, 0, []byte{0x0, 0x4d, 0x52, 0x4d}, nil, nil}}},
-	{982, []*magicMatch{{0, 0, []byte{0x4d, 0x4f, 0x56, 0x49}, nil, nil}}},
-	{624, []*magicMatch{{0, 0, []byte{0x45, 0x50, 0x2a, 0x0}, nil, nil}}},
-	{181, []*magicMatch{{8, 0, []byte{0x19, 0x4, 0x0, 0x10}, nil, nil}}},
-	{640, []*magicMatch{{0, 0, []byte{0x28, 0x0, 0x0, 0x0}, nil, nil}}},
-	{7, []*magicMatch{{128, 0, []byte{0x44, 0x49, 0x43, 0x4d}, nil, nil}}},
-	{613, []*magicMatch{{0, 0, []byte{0x89, 0x50, 0x4e, 0x47}, nil, nil}}},
-	{190, []*magicMatch{{0, 0, []byte{0x3f, 0x5f, 0x3, 0x0}, nil, nil}}},
-	{195, []*magicMatch{{0, 0, []byte{0x44, 0x4f, 0x53, 0x0}, nil, nil}}},
-	{980, []*magicMatch{{0, 0, []byte{0x4e, 0x53, 0x56, 0x66}, nil, nil}}},
-	{361, []*magicMatch{{0, 0, []byte{0x23, 0x4c, 0x79, 0x58}, nil, nil}}},
-	{313, []*magicMatch{{0, 0, []byte{0xfe, 0xed, 0xfe, 0xed}, nil, nil}}},
-	{676, []*magicMatch{{4, 0, []byte{0x69, 0x64, 0x61, 0x74}, nil, nil}}},
-	{311, []*magicMatch{{0, 0, []byte{0xce, 0xce, 0xce, 0xce}, nil, nil}}},
-	{604, []*magicMatch{{0, 0, []byte{0x47, 0x49, 0x46, 0x38}, nil, nil}}},
-	{684, []*magicMatch{{0, 0, []byte{0x59, 0xa6, 0x6a, 0x95}, nil, nil}}},
-	{599, []*magicMatch{{0, 0, []byte{0x53, 0x44, 0x50, 0x58}, nil, nil}}},
-	{371, []*magicMatch{{102, 0, []byte{0x6d, 0x42, 0x49, 0x4e}, nil, nil}}},
-	{308, []*magicMatch{{0, 0, []byte{0xca, 0xfe, 0xba, 0xbe}, nil, nil}}},
-	{962, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, nil}}},
-	{596, []*magicMatch{{0, 0, []byte{0x77, 0x4f, 0x46, 0x32}, nil, nil}}},
-	{692, []*magicMatch{{0, 0, []byte{0x58, 0x63, 0x75, 0x72}, nil, nil}}},
-	{693, []*magicMatch{{0, 0, []byte{0x23, 0x46, 0x49, 0x47}, nil, nil}}},
-	{595, []*magicMatch{{0, 0, []byte{0x77, 0x4f, 0x46, 0x46}, nil, nil}}},
-	{593, []*magicMatch{{0, 0, []byte{0x4f, 0x54, 0x54, 0x4f}, nil, nil}}},
-	{88, []*magicMatch{{36, 0, []byte{0x61, 0x63, 0x73, 0x70}, nil, nil}}},
-	{399, []*magicMatch{{31, 0, []byte{0x4f, 0x6c, 0x65, 0x6f}, nil, nil}}},
-	{588, []*magicMatch{{0, 0, []byte{0x77, 0x76, 0x70, 0x6b}, nil, nil}}},
-	{587, []*magicMatch{{0, 0, []byte{0x77, 0x76, 0x70, 0x6b}, nil, nil}}},
-	{583, []*magicMatch{{0, 0, []byte{0x54, 0x54, 0x41, 0x31}, nil, nil}}},
-	{209, []*magicMatch{{0, 0, []byte{0x4c, 0x59, 0x4e, 0x58}, nil, nil}}},
-	{955, []*magicMatch{{0, 0, []byte{0x1f, 0x7, 0x0, 0x0}, []byte{0xff, 0xff, 0xff, 0x0}, nil}}},
-	{578, []*magicMatch{{44, 0, []byte{0x53, 0x43, 0x52, 0x4d}, nil, nil}}},
-	{406, []*magicMatch{{0, 0, []byte{0x4a, 0x6f, 0x79, 0x21}, nil, nil}}},
-	{546, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, nil}}},
-	{417, []*magicMatch{{0, 0, []byte{0x99, 0x4e, 0xd, 0xa}, nil, nil}}},
-	{276, []*magicMatch{{28, 0, []byte{0xc2, 0x33, 0x9f, 0x3d}, nil, nil}}},
-	{273, []*magicMatch{{0, 0, []byte{0x46, 0x4f, 0x4e, 0x54}, nil, nil}}},
-	{428, []*magicMatch{{0, 0, []byte{0xed, 0xab, 0xee, 0xdb}, nil, nil}}},
-	{444, []*magicMatch{{0, 0, []byte{0x4d, 0x4d, 0x4d, 0x44}, nil, nil}}},
-	{561, []*magicMatch{{0, 0, []byte{0x49, 0x4d, 0x50, 0x4d}, nil, nil}}},
-	{32, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, nil}}},
-	{557, []*magicMatch{{0, 0, []byte{0x4d, 0x41, 0x43, 0x20}, nil, nil}}},
-	{554, []*magicMatch{{8, 0, []byte{0x41, 0x49, 0x46, 0x43}, nil, nil}}},
-	{265, []*magicMatch{{0, 0, []byte{0x1, 0x66, 0x63, 0x70}, nil, nil}}},
-	{263, []*magicMatch{{0, 0, []byte{0x14, 0x2, 0x59, 0x19}, nil, nil}}},
-	{254, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x27, 0xa}, nil, nil}}},
-	{547, []*magicMatch{{0, 0, []byte{0x50, 0x53, 0x49, 0x44}, nil, nil}}},
-	{253, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x27, 0xa}, nil, nil}}},
-	{239, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0x7b}, nil, nil}}},
-	{355, []*magicMatch{{0, 0, []byte{0xd, 0x1a, 0x27, 0x1}, nil, nil}}},
-	{225, []*magicMatch{{0, 0, []byte{0x43, 0x43, 0x4d, 0x58}, nil, nil}}},
-	{542, []*magicMatch{{0, 0, []byte{0x4d, 0x54, 0x68, 0x64}, nil, nil}}},
-	{441, []*magicMatch{{0, 0, []byte{0x61, 0x6a, 0x6b, 0x67}, nil, nil}}},
-	{824, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x48, 0x54, 0x4d, 0x4c}, nil, nil}, {0, 256, []byte{0x3c, 0x21, 0x64, 0x6f, 0x63, 0x74, 0x79, 0x70, 0x65, 0x20, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}, {0, 256, []byte{0x3c, 0x48, 0x45, 0x41, 0x44}, nil, nil}, {0, 256, []byte{0x3c, 0x68, 0x65, 0x61, 0x64}, nil, nil}, {0, 256, []byte{0x3c, 0x54, 0x49, 0x54, 0x4c, 0x45}, nil, nil}, {0, 256, []byte{0x3c, 0x74, 0x69, 0x74, 0x6c, 0x65}, nil, nil}, {0, 256, []byte{0x3c, 0x48, 0x54, 0x4d, 0x4c}, nil, nil}, {0, 256, []byte{0x3c, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}, {0, 256, []byte{0x3c, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54}, nil, nil}, {0, 256, []byte{0x3c, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74}, nil, nil}, {0, 0, []byte{0x3c, 0x42, 0x4f, 0x44, 0x59}, nil, nil}, {0, 0, []byte{0x3c, 0x62, 0x6f, 0x64, 0x79}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x3c, 0x68, 0x31}, nil, nil}, {0, 0, []byte{0x3c, 0x48, 0x31}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x64, 0x6f, 0x63, 0x74, 0x79, 0x70, 0x65, 0x20, 0x48, 0x54, 0x4d, 0x4c}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}}},
-	{674, []*magicMatch{{0, 0, []byte{0x50, 0x32}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}, {0, 0, []byte{0x50, 0x35}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}}},
-	{673, []*magicMatch{{0, 0, []byte{0x50, 0x31}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}, {0, 0, []byte{0x50, 0x34}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}}},
-	{675, []*magicMatch{{0, 0, []byte{0x50, 0x33}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}, {0, 0, []byte{0x50, 0x36}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}}},
-	{597, []*magicMatch{{0, 0, []byte{0x42, 0x4d, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, []byte{0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff}, nil}, {0, 0, []byte{0x42, 0x4d}, nil, []*magicMatch{{14, 0, []byte{0xc}, nil, nil}, {14, 0, []byte{0x40}, nil, nil}, {14, 0, []byte{0x28}, nil, nil}}}}},
-	{686, []*magicMatch{{1, 0, []byte{0x0, 0x2}, nil, []*magicMatch{{16, 0, []byte{0x8}, nil, nil}, {16, 0, []byte{0x10}, nil, nil}, {16, 0, []byte{0x18}, nil, nil}, {16, 0, []byte{0x20}, nil, nil}}}}},
-	{268, []*magicMatch{{0, 0, []byte{0x53, 0x74, 0x61, 0x72, 0x74, 0x46, 0x6f, 0x6e, 0x74}, nil, nil}, {0, 0, []byte{0x13, 0x7a, 0x29}, nil, nil}, {8, 0, []byte{0x13, 0x7a, 0x2b}, nil, nil}}},
-	{68, []*magicMatch{{0, 0, []byte{0x46, 0x57, 0x53}, nil, nil}, {0, 0, []byte{0x43, 0x57, 0x53}, nil, nil}}},
-	{832, []*magicMatch{{0, 0, []byte{0x49, 0x44, 0x3b}, nil, nil}}},
-	{566, []*magicMatch{{0, 0, []byte{0x4d, 0x4f, 0x33}, nil, nil}}},
-	{571, []*magicMatch{{0, 0, []byte{0x4d, 0x50, 0x2b}, nil, nil}}},
-	{575, []*magicMatch{{0, 0, []byte{0x50, 0x53, 0x46}, nil, nil}}},
-	{194, []*magicMatch{{0, 0, []byte{0x41, 0x4c, 0x5a}, nil, nil}}},
-	{217, []*magicMatch{{0, 0, []byte{0x42, 0x5a, 0x68}, nil, nil}}},
-	{639, []*magicMatch{{0, 0, []byte{0x44, 0x44, 0x53}, nil, nil}}},
-	{839, []*magicMatch{{0, 256, []byte{0x3c, 0x54, 0x53}, nil, nil}}},
-	{971, []*magicMatch{{0, 0, []byte{0x46, 0x4c, 0x56}, nil, nil}}},
-	{188, []*magicMatch{{1, 0, []byte{0x57, 0x50, 0x43}, nil, nil}}},
-	{37, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x20, 0x4b, 0x45, 0x59, 0x20, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x20, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x95, 0x1}, nil, nil}, {0, 0, []byte{0x95, 0x0}, nil, nil}, {0, 0, []byte{0x99, 0x0}, nil, nil}, {0, 0, []byte{0x99, 0x1}, nil, nil}}},
-	{627, []*magicMatch{{0, 0, []byte{0xa}, nil, []*magicMatch{{1, 0, []byte{0x0}, nil, nil}, {1, 0, []byte{0x2}, nil, nil}, {1, 0, []byte{0x3}, nil, nil}, {1, 0, []byte{0x5}, nil, nil}}}}},
-	{834, []*magicMatch{{0, 0, []byte{0x2e, 0x5c, 0x22}, nil, nil}, {0, 0, []byte{0x27, 0x5c, 0x22}, nil, nil}, {0, 0, []byte{0x27, 0x2e, 0x5c, 0x22}, nil, nil}, {0, 0, []byte{0x5c, 0x22}, nil, nil}}},
-	{895, []*magicMatch{{0, 0, []byte{0x7b, 0x31, 0x7d}, nil, nil}, {0, 0, []byte{0x7b, 0x30, 0x7d}, nil, nil}, {0, 6, []byte{0x7d, 0x7b}, nil, nil}}},
-	{269, []*magicMatch{{0, 0, []byte{0xf7, 0x83}, nil, nil}, {0, 0, []byte{0xf7, 0x59}, nil, nil}, {0, 0, []byte{0xf7, 0xca}, nil, nil}}},
-	{608, []*magicMatch{{0, 0, []byte{0xff, 0xd8, 0xff}, nil, nil}, {0, 0, []byte{0xff, 0xd8}, nil, nil}}},
-	{54, []*magicMatch{{0, 0, []byte{0x4, 0x25, 0x21}, nil, nil}, {0, 0, []byte{0x25, 0x21}, nil, nil}}},
-	{545, []*magicMatch{{0, 0, []byte{0xff, 0xfb}, nil, nil}, {0, 0, []byte{0x49, 0x44, 0x33}, nil, nil}}},
-	{866, []*magicMatch{{0, 0, []byte{0xa, 0x28}, nil, nil}, {0, 0, []byte{0x3b, 0x45, 0x4c, 0x43, 0x13, 0x0, 0x0, 0x0}, nil, nil}}},
-	{537, []*magicMatch{{0, 0, []byte{0x41, 0x44, 0x49, 0x46}, nil, nil}, {0, 0, []byte{0xff, 0xf0}, []byte{0xff, 0xf6}, nil}}},
-	{270, []*magicMatch{{2, 0, []byte{0x0, 0x11}, nil, nil}, {2, 0, []byte{0x0, 0x12}, nil, nil}}},
-	{970, []*magicMatch{{0, 0, []byte{0x11, 0xaf}, nil, nil}, {0, 0, []byte{0x12, 0xaf}, nil, nil}}},
-	{380, []*magicMatch{{0, 0, []byte{0x4d, 0x5a}, nil, nil}}},
-	{205, []*magicMatch{{0, 0, []byte{0x60, 0xea}, nil, nil}}},
-	{14, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, nil}}},
-	{250, []*magicMatch{{0, 0, []byte{0xf7, 0x2}, nil, nil}}},
-	{538, []*magicMatch{{0, 0, []byte{0xb, 0x77}, nil, nil}}},
-	{232, []*magicMatch{{0, 0, []byte{0x1f, 0x9d}, nil, nil}}},
-	{264, []*magicMatch{{0, 0, []byte{0x36, 0x4}, nil, nil}}},
-	{204, []*magicMatch{{0, 0, []byte{0x3c, 0x61, 0x72, 0x3e}, nil, nil}, {0, 0, []byte{0x21, 0x3c, 0x61, 0x72, 0x63, 0x68, 0x3e}, nil, nil}}},
-	{427, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, nil}}},
-	{409, []*magicMatch{{0, 256, []byte{0xa, 0x3d, 0x70, 0x6f, 0x64}, nil, nil}, {0, 256, []byte{0xa, 0x3d, 0x68, 0x65, 0x61, 0x64, 0x31, 0x20, 0x4e, 0x41, 0x4d, 0x45}, nil, nil}, {0, 256, []byte{0xa, 0x3d, 0x68, 0x65, 0x61, 0x64, 0x31, 0x20, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}}},
-	{527, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x2d, 0x2d}, nil, nil}}},
-	{302, []*magicMatch{{0, 0, []byte{0x46, 0x4f, 0x52, 0x4d}, nil, nil}}},
-	{540, []*magicMatch{{0, 0, []byte{0x2e, 0x73, 0x6e, 0x64}, nil, nil}}},
-	{567, []*magicMatch{{0, 0, []byte{0x4d, 0x54, 0x4d}, nil, nil}, {0, 0, []byte{0x4d, 0x4d, 0x44, 0x30}, nil, nil}, {0, 0, []byte{0x4d, 0x4d, 0x44, 0x31}, nil, nil}, {0, 0, []byte{0x69, 0x66}, nil, []*magicMatch{{110, 0, []byte{0x0}, []byte{0xc0}, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}, {110, 0, []byte{0x40}, nil, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}}}, {0, 0, []byte{0x4a, 0x4e}, nil, []*magicMatch{{110, 0, []byte{0x0}, []byte{0xc0}, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}, {110, 0, []byte{0x40}, nil, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}}}, {0, 0, []byte{0x4d, 0x41, 0x53, 0x5f, 0x55, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x56, 0x30, 0x30}, nil, nil}}},
-	{972, []*magicMatch{{0, 0, []byte{0x46, 0x4c, 0x56}, nil, nil}}},
-	{255, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x2, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x2}, nil, nil}}}}}, {0, 0, []byte{0x4d, 0x5a}, nil, nil}, {0, 0, []byte{0x1c, 0x52}, nil, nil}, {0, 0, []byte{0x1, 0x10}, nil, nil}, {0, 0, []byte{0x1, 0x11}, nil, nil}, {0, 0, []byte{0x83, 0x1}, nil, nil}}},
-	{378, []*magicMatch{{60, 0, []byte{0x54, 0x45, 0x58, 0x74, 0x52, 0x45, 0x41, 0x64}, nil, nil}}},
-	{378, []*magicMatch{{60, 0, []byte{0x54, 0x45, 0x58, 0x74, 0x52, 0x45, 0x41, 0x64}, nil, nil}}},
-	{905, []*magicMatch{{0, 0, []byte{0x23, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74}, nil, nil}}},
-	{860, []*magicMatch{{0, 0, []byte{0x2f, 0x2a}, nil, nil}, {0, 0, []byte{0x2f, 0x2f}, nil, nil}, {0, 0, []byte{0x23, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65}, nil, nil}}},
-	{24, []*magicMatch{{0, 0, []byte{0x46, 0x72, 0x6f, 0x6d, 0x20}, nil, nil}}},
-	{686, []*magicMatch{{1, 0, []byte{0x1, 0x1}, nil, nil}, {1, 0, []byte{0x1, 0x9}, nil, nil}, {1, 0, []byte{0x0, 0x3}, nil, nil}, {1, 0, []byte{0x0, 0xa}, nil, nil}, {1, 0, []byte{0x0, 0xb}, nil, nil}}},
-	{897, []*magicMatch{{0, 0, []byte{0x2f, 0x2f}, nil, nil}}},
-	{892, []*magicMatch{{0, 0, []byte{0x23, 0x23}, nil, nil}}},
-	{933, []*magicMatch{{0, 0, []byte{0x25}, nil, nil}}},
-	{892, []*magicMatch{{0, 0, []byte{0x25}, nil, nil}}},
+	{247, 90, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{0, 100, []byte{0x2d, 0x2f, 0x2f, 0x4f, 0x41, 0x53, 0x49, 0x53, 0x2f, 0x2f, 0x44, 0x54, 0x44, 0x20, 0x44, 0x6f, 0x63, 0x42, 0x6f, 0x6f, 0x6b, 0x20, 0x58, 0x4d, 0x4c}, nil, nil}, {0, 100, []byte{0x2d, 0x2f, 0x2f, 0x4b, 0x44, 0x45, 0x2f, 0x2f, 0x44, 0x54, 0x44, 0x20, 0x44, 0x6f, 0x63, 0x42, 0x6f, 0x6f, 0x6b, 0x20, 0x58, 0x4d, 0x4c}, nil, nil}}}}},
+	{170, 90, []*magicMatch{{2089, 0, []byte{0x53, 0x74, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}},
+	{641, 90, []*magicMatch{{0, 0, []byte{0x25, 0x21}, nil, []*magicMatch{{15, 0, []byte{0x45, 0x50, 0x53}, nil, nil}}}, {0, 0, []byte{0x4, 0x25, 0x21}, nil, []*magicMatch{{16, 0, []byte{0x45, 0x50, 0x53}, nil, nil}}}, {0, 0, []byte{0xc5, 0xd0, 0xd3, 0xc6}, nil, nil}}},
+	{379, 80, []*magicMatch{{0, 64, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x4e, 0x45, 0x54, 0x53, 0x43, 0x41, 0x50, 0x45, 0x2d, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x2d, 0x66, 0x69, 0x6c, 0x65, 0x2d, 0x31, 0x3e}, nil, nil}}},
+	{249, 80, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31, 0x2e, 0x30, 0x22, 0x3f, 0x3e, 0xa, 0x3c, 0x64, 0x72, 0x67, 0x65, 0x6e, 0x69, 0x75, 0x73, 0x3e}, nil, nil}}},
+	{185, 80, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65}, nil, nil}}},
+	{657, 80, []*magicMatch{{242, 0, []byte{0x45, 0x41, 0x53, 0x54, 0x4d, 0x41, 0x4e, 0x20, 0x4b, 0x4f, 0x44, 0x41, 0x4b, 0x20, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x4e, 0x59}, nil, nil}}},
+	{340, 80, []*magicMatch{{0, 0, []byte{0x5b, 0x30, 0x2d, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x40, 0x2d, 0x35, 0x30, 0x2c, 0x2d, 0x35, 0x30, 0x5d}, nil, nil}}},
+	{221, 80, []*magicMatch{{0, 0, []byte{0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x20, 0x43, 0x61, 0x62, 0x72, 0x69, 0x49, 0x49, 0x20, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x20}, nil, nil}, {0, 0, []byte{0x46, 0x69, 0x67, 0x75, 0x72, 0x65, 0x20, 0x43, 0x61, 0x62, 0x72, 0x69, 0x20, 0x49, 0x49, 0x20}, nil, nil}}},
+	{184, 80, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x63, 0x64, 0x66}, nil, nil}}},
+	{186, 80, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x6e, 0x62}, nil, nil}, {0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}, {0, 100, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}}},
+	{336, 80, []*magicMatch{{0, 0, []byte{0x5b, 0x30, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x47, 0x61, 0x6d, 0x65, 0x5d}, nil, nil}}},
+	{187, 80, []*magicMatch{{0, 100, []byte{0x76, 0x6e, 0x64, 0x2e, 0x77, 0x6f, 0x6c, 0x66, 0x72, 0x61, 0x6d, 0x2e, 0x77, 0x6c}, nil, nil}}},
+	{91, 80, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x6b, 0x63, 0x66, 0x67}, nil, nil}}},
+	{95, 80, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x67, 0x75, 0x69}, nil, nil}, {0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x6b, 0x70, 0x61, 0x72, 0x74, 0x67, 0x75, 0x69}, nil, nil}}},
+	{611, 80, []*magicMatch{{0, 0, []byte{0xab, 0x4b, 0x54, 0x58}, nil, []*magicMatch{{4, 0, []byte{0x20, 0x31, 0x31, 0xbb}, nil, []*magicMatch{{8, 0, []byte{0xd, 0xa, 0x1a, 0xa}, nil, nil}}}}}}},
+	{572, 80, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x4f, 0x70, 0x75, 0x73, 0x48, 0x65, 0x61, 0x64}, nil, nil}}}}},
+	{257, 80, []*magicMatch{{0, 256, []byte{0x3c, 0x46, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6b}, nil, nil}}},
+	{983, 80, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x80, 0x74, 0x68, 0x65, 0x6f, 0x72, 0x61}, nil, nil}}}}},
+	{585, 80, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x1, 0x76, 0x6f, 0x72, 0x62, 0x69, 0x73}, nil, nil}}}}},
+	{581, 80, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x53, 0x70, 0x65, 0x65, 0x78, 0x20, 0x20}, nil, nil}}}}},
+	{915, 80, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x71, 0x6d, 0x6c}, nil, nil}, {0, 3000, []byte{0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x51, 0x74}, nil, []*magicMatch{{9, 3000, []byte{0x7b}, nil, nil}}}, {0, 3000, []byte{0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x51, 0x6d, 0x6c}, nil, []*magicMatch{{9, 3000, []byte{0x7b}, nil, nil}}}}},
+	{915, 80, []*magicMatch{{0, 256, []byte{0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x51, 0x74, 0x20}, nil, nil}}},
+	{981, 80, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{29, 0, []byte{0x76, 0x69, 0x64, 0x65, 0x6f}, nil, nil}}}}},
+	{620, 80, []*magicMatch{{0, 0, []byte{0x41, 0x54, 0x26, 0x54, 0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{12, 0, []byte{0x44, 0x4a, 0x56, 0x4d}, nil, nil}}}, {0, 0, []byte{0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{8, 0, []byte{0x44, 0x4a, 0x56, 0x4d}, nil, nil}}}}},
+	{619, 80, []*magicMatch{{0, 0, []byte{0x41, 0x54, 0x26, 0x54, 0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{12, 0, []byte{0x44, 0x4a, 0x56, 0x55}, nil, nil}}}, {0, 0, []byte{0x46, 0x4f, 0x52, 0x4d}, nil, []*magicMatch{{8, 0, []byte{0x44, 0x4a, 0x56, 0x55}, nil, nil}}}}},
+	{558, 80, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x4c, 0x61, 0x43}, nil, nil}}}, {0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x7f, 0x46, 0x4c, 0x41, 0x43}, nil, nil}}}}},
+	{378, 80, []*magicMatch{{60, 0, []byte{0x42, 0x4f, 0x4f, 0x4b, 0x4d, 0x4f, 0x42, 0x49}, nil, nil}}},
+	{76, 80, []*magicMatch{{8, 0, []byte{0x43, 0x44, 0x52, 0x0, 0x76, 0x72, 0x73, 0x6e}, []byte{0xff, 0xff, 0xff, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
+	{55, 80, []*magicMatch{{60, 0, []byte{0x44, 0x61, 0x74, 0x61, 0x50, 0x6c, 0x6b, 0x72}, nil, nil}}},
+	{353, 80, []*magicMatch{{0, 0, []byte{0x4b, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54}, nil, nil}}},
+	{526, 80, []*magicMatch{{0, 256, []byte{0x3c, 0x78, 0x6c, 0x69, 0x66, 0x66}, nil, nil}}},
+	{410, 80, []*magicMatch{{0, 64, []byte{0x3c, 0x3f, 0x70, 0x68, 0x70}, nil, nil}}},
+	{615, 80, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x73, 0x76, 0x67}, nil, nil}, {0, 256, []byte{0x3c, 0x73, 0x76, 0x67}, nil, nil}}},
+	{837, 80, []*magicMatch{{0, 0, []byte{0x25, 0x61, 0x62, 0x63}, nil, nil}}},
+	{670, 80, []*magicMatch{{0, 0, []byte{0x53, 0x80, 0xf6, 0x34}, nil, nil}}},
+	{663, 80, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x4e, 0x31}, nil, nil}}},
+	{402, 80, []*magicMatch{{0, 0, []byte{0x50, 0x41, 0x43, 0x4b}, nil, nil}}},
+	{400, 80, []*magicMatch{{0, 256, []byte{0x3c, 0x6f, 0x73, 0x6d}, nil, nil}}},
+	{396, 80, []*magicMatch{{0, 256, []byte{0x3c, 0x6e, 0x7a, 0x62}, nil, nil}}},
+	{40, 75, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x20, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x4e, 0x45, 0x57, 0x20, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x20, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{408, 75, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x52, 0x53, 0x41, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x44, 0x53, 0x41, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x45, 0x43, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{47, 75, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x45, 0x44, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{50, 75, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{52, 75, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x58, 0x35, 0x30, 0x39, 0x20, 0x43, 0x52, 0x4c, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{42, 75, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x32, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{44, 75, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x4b, 0x43, 0x53, 0x37, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{450, 75, []*magicMatch{{0, 0, []byte{0x53, 0x50, 0x4b, 0x41, 0x43, 0x3d}, nil, nil}}},
+	{139, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
+	{142, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
+	{135, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
+	{132, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
+	{129, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
+	{147, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
+	{137, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}}}}}}},
+	{145, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
+	{140, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}}}}}},
+	{148, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x77, 0x65, 0x62}, nil, nil}}}}}}},
+	{133, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73}, nil, nil}}}}}}},
+	{131, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61}, nil, nil}}}}}}},
+	{136, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x69, 0x6d, 0x61, 0x67, 0x65}, nil, nil}}}}}}},
+	{128, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x74}, nil, nil}}}}}}},
+	{143, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74}, nil, nil}}}}}}},
+	{130, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65}, nil, nil}}}}}}},
+	{176, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73}, nil, nil}}}}}}},
+	{175, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73}, nil, nil}}}}}}},
+	{180, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
+	{179, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
+	{178, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
+	{177, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x6d, 0x61, 0x74, 0x68}, nil, nil}}}}}}},
+	{174, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x64, 0x72, 0x61, 0x77}, nil, nil}}}}}}},
+	{173, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x64, 0x72, 0x61, 0x77}, nil, nil}}}}}}},
+	{172, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x63, 0x61, 0x6c, 0x63}, nil, nil}}}}}}},
+	{171, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x63, 0x61, 0x6c, 0x63}, nil, nil}}}}}}},
+	{9, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x70, 0x75, 0x62, 0x2b, 0x7a, 0x69, 0x70}, nil, nil}, {43, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x70, 0x75, 0x62, 0x2b, 0x7a, 0x69, 0x70}, nil, nil}}}}}}},
+	{612, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x73, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
+	{71, 70, []*magicMatch{{0, 0, []byte{0x23, 0x45, 0x58, 0x54, 0x4d, 0x33, 0x55}, nil, []*magicMatch{{0, 128, []byte{0x23, 0x45, 0x58, 0x54, 0x2d, 0x58, 0x2d, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}, {0, 128, []byte{0x23, 0x45, 0x58, 0x54, 0x2d, 0x58, 0x2d, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x2d, 0x49, 0x4e, 0x46}, nil, nil}}}}},
+	{307, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x61, 0x70, 0x78, 0x6c}, nil, nil}}}}},
+	{523, 70, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 226, []byte{0x2e, 0x66, 0x62, 0x32}, nil, nil}}}}},
+	{926, 70, []*magicMatch{{0, 0, []byte{0x53, 0x30}, nil, []*magicMatch{{4, 0, []byte{0x30, 0x30, 0x30, 0x30}, nil, nil}}}}},
+	{910, 70, []*magicMatch{{0, 256, []byte{0x3c, 0x6f, 0x70, 0x6d, 0x6c, 0x20}, nil, nil}}},
+	{4, 70, []*magicMatch{{0, 256, []byte{0x3c, 0x66, 0x65, 0x65, 0x64, 0x20}, nil, nil}}},
+	{61, 70, []*magicMatch{{0, 256, []byte{0x3c, 0x72, 0x73, 0x73, 0x20}, nil, nil}, {0, 256, []byte{0x3c, 0x52, 0x53, 0x53, 0x20}, nil, nil}}},
+	{881, 70, []*magicMatch{{0, 0, []byte{0x3a}, nil, nil}}},
+	{138, 60, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
+	{141, 60, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
+	{134, 60, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x73, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
+	{144, 60, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{4, 96, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74}, nil, []*magicMatch{{100, 3900, []byte{0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x3a, 0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x66, 0x6c, 0x61, 0x74, 0x2d, 0x78, 0x6d, 0x6c, 0x22}, nil, nil}}}}}}},
+	{146, 60, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x6f, 0x61, 0x73, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65}, nil, nil}}}}}}},
+	{332, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x69, 0x6c, 0x6c, 0x75, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4, 0x6}, nil, nil}}}}}}},
+	{342, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72}, nil, nil}}}}}}},
+	{327, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61}, nil, nil}}}}}}},
+	{345, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64}, nil, nil}}}}}}},
+	{338, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72}, nil, nil}}}}}}},
+	{319, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x63, 0x68, 0x61, 0x72, 0x74, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x63, 0x68, 0x61, 0x72, 0x74}, nil, nil}}}}}}},
+	{317, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x61, 0x72, 0x62, 0x6f, 0x6e}, nil, nil}}}}}}},
+	{354, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x77, 0x6f, 0x72, 0x64, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x77, 0x6f, 0x72, 0x64}, nil, nil}}}}}}},
+	{343, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x72, 0x69, 0x74, 0x61, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x72, 0x69, 0x74, 0x61}, nil, nil}}}}}}},
+	{333, 60, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, []*magicMatch{{10, 0, []byte{0x4b, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65}, nil, []*magicMatch{{18, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x69, 0x76, 0x69, 0x6f, 0x4, 0x6}, nil, nil}}}}}, {0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x6b, 0x69, 0x76, 0x69, 0x6f}, nil, nil}}}}}}},
+	{434, 60, []*magicMatch{{0, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x44, 0x49, 0x53, 0x43, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d}, nil, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41}, nil, nil}}}, {16, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x44, 0x49, 0x53, 0x43, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d}, nil, []*magicMatch{{272, 0, []byte{0x53, 0x45, 0x47, 0x41}, nil, nil}}}}},
+	{525, 60, []*magicMatch{{0, 256, []byte{0x2f, 0x2f, 0x57, 0x33, 0x43, 0x2f, 0x2f, 0x44, 0x54, 0x44, 0x20, 0x58, 0x48, 0x54, 0x4d, 0x4c, 0x20}, nil, nil}, {0, 256, []byte{0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x77, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x54, 0x52, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c, 0x31, 0x2f, 0x44, 0x54, 0x44, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c, 0x31, 0x2d, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x2e, 0x64, 0x74, 0x64}, nil, nil}, {0, 256, []byte{0x3c, 0x68, 0x74, 0x6d, 0x6c, 0x20, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x3d, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x77, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x31, 0x39, 0x39, 0x39, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}, {0, 256, []byte{0x3c, 0x48, 0x54, 0x4d, 0x4c, 0x20, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x3d, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x77, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x31, 0x39, 0x39, 0x39, 0x2f, 0x78, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}}},
+	{914, 60, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33}, nil, nil}}},
+	{939, 60, []*magicMatch{{0, 0, []byte{0x25, 0x21, 0x70, 0x6f, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x63}, nil, nil}, {0, 0, []byte{0x25, 0x21, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67}, nil, nil}}},
+	{368, 60, []*magicMatch{{0, 0, []byte{0x89, 0x4c, 0x5a, 0x4f, 0x0, 0xd, 0xa, 0x1a, 0xa}, nil, nil}}},
+	{422, 60, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, []*magicMatch{{0, 64, []byte{0x3c, 0x3f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65}, nil, nil}}}, {0, 0, []byte{0x52, 0x54, 0x53, 0x50, 0x74, 0x65, 0x78, 0x74}, nil, nil}, {0, 0, []byte{0x72, 0x74, 0x73, 0x70, 0x74, 0x65, 0x78, 0x74}, nil, nil}, {0, 0, []byte{0x53, 0x4d, 0x49, 0x4c, 0x74, 0x65, 0x78, 0x74}, nil, nil}}},
+	{418, 60, []*magicMatch{{0, 0, []byte{0x71, 0x70, 0x72, 0x65, 0x73, 0x73, 0x31, 0x30}, nil, nil}}},
+	{102, 60, []*magicMatch{{0, 0, []byte{0x4d, 0x53, 0x43, 0x46, 0x0, 0x0, 0x0, 0x0}, nil, nil}}},
+	{193, 60, []*magicMatch{{7, 0, []byte{0x2a, 0x2a, 0x41, 0x43, 0x45, 0x2a, 0x2a}, nil, nil}}},
+	{460, 60, []*magicMatch{{257, 0, []byte{0x75, 0x73, 0x74, 0x61, 0x72, 0x0}, nil, nil}, {257, 0, []byte{0x75, 0x73, 0x74, 0x61, 0x72, 0x20, 0x20, 0x0}, nil, nil}}},
+	{518, 60, []*magicMatch{{0, 0, []byte{0xfd, 0x37, 0x7a, 0x58, 0x5a, 0x0}, nil, nil}}},
+	{191, 60, []*magicMatch{{0, 0, []byte{0x37, 0x7a, 0xbc, 0xaf, 0x27, 0x1c}, nil, nil}}},
+	{357, 60, []*magicMatch{{2, 0, []byte{0x2d, 0x6c, 0x68, 0x20, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x30, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x31, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x32, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x33, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x34, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x35, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x34, 0x30, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x68, 0x64, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x7a, 0x34, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x7a, 0x35, 0x2d}, nil, nil}, {2, 0, []byte{0x2d, 0x6c, 0x7a, 0x73, 0x2d}, nil, nil}}},
+	{126, 60, []*magicMatch{{0, 256, []byte{0x3c, 0x3f, 0x77, 0x70, 0x6c}, nil, nil}}},
+	{27, 60, []*magicMatch{{0, 0, []byte{0x31, 0xbe, 0x0, 0x0}, nil, nil}, {0, 0, []byte{0x50, 0x4f, 0x5e, 0x51, 0x60}, nil, nil}, {0, 0, []byte{0xfe, 0x37, 0x0, 0x23}, nil, nil}, {0, 0, []byte{0xdb, 0xa5, 0x2d, 0x0, 0x0, 0x0}, nil, nil}, {2112, 0, []byte{0x4d, 0x53, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x6f, 0x63}, nil, nil}, {2108, 0, []byte{0x4d, 0x53, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x6f, 0x63}, nil, nil}, {2112, 0, []byte{0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x20, 0x57, 0x6f, 0x72, 0x64, 0x20, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x64, 0x61, 0x74, 0x61}, nil, nil}, {546, 0, []byte{0x62, 0x6a, 0x62, 0x6a}, nil, nil}, {546, 0, []byte{0x6a, 0x62, 0x6a, 0x62}, nil, nil}}},
+	{272, 60, []*magicMatch{{0, 0, []byte{0x4c, 0x57, 0x46, 0x4e}, nil, nil}, {65, 0, []byte{0x4c, 0x57, 0x46, 0x4e}, nil, nil}, {0, 0, []byte{0x25, 0x21, 0x50, 0x53, 0x2d, 0x41, 0x64, 0x6f, 0x62, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x2d, 0x31, 0x2e}, nil, nil}, {6, 0, []byte{0x25, 0x21, 0x50, 0x53, 0x2d, 0x41, 0x64, 0x6f, 0x62, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x2d, 0x31, 0x2e}, nil, nil}, {0, 0, []byte{0x25, 0x21, 0x46, 0x6f, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x31, 0x2d, 0x31, 0x2e}, nil, nil}, {6, 0, []byte{0x25, 0x21, 0x46, 0x6f, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x31, 0x2d, 0x31, 0x2e}, nil, nil}}},
+	{203, 60, []*magicMatch{{0, 0, []byte{0x1a, 0x8, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x9, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x2, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x3, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x4, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}, {0, 0, []byte{0x1a, 0x6, 0x0, 0x0}, []byte{0xff, 0xff, 0x80, 0x80}, nil}}},
+	{454, 60, []*magicMatch{{0, 0, []byte{0x53, 0x74, 0x75, 0x66, 0x66, 0x49, 0x74, 0x20}, nil, nil}, {0, 0, []byte{0x53, 0x49, 0x54, 0x21}, nil, nil}}},
+	{362, 60, []*magicMatch{{0, 0, []byte{0x4, 0x22, 0x4d, 0x18}, nil, nil}, {0, 0, []byte{0x2, 0x21, 0x4c, 0x18}, nil, nil}}},
+	{550, 60, []*magicMatch{{0, 18725, []byte{0x64, 0x58, 0x20, 0x25}, nil, nil}}},
+	{533, 60, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, nil}}},
+	{524, 60, []*magicMatch{{20, 0, []byte{0xdc, 0xa7, 0xc4, 0xfd}, nil, nil}}},
+	{515, 60, []*magicMatch{{0, 0, []byte{0x78, 0x61, 0x72, 0x21}, nil, nil}}},
+	{403, 60, []*magicMatch{{0, 0, []byte{0x50, 0x41, 0x52, 0x32}, nil, nil}}},
+	{364, 60, []*magicMatch{{0, 0, []byte{0x4c, 0x5a, 0x49, 0x50}, nil, nil}}},
+	{359, 60, []*magicMatch{{0, 0, []byte{0x4c, 0x52, 0x5a, 0x49}, nil, nil}}},
+	{314, 60, []*magicMatch{{0, 0, []byte{0xca, 0xfe, 0xd0, 0xd}, nil, nil}}},
+	{159, 60, []*magicMatch{{0, 0, []byte{0x52, 0x61, 0x72, 0x21}, nil, nil}}},
+	{235, 60, []*magicMatch{{0, 0, []byte{0x71, 0xc7}, nil, nil}, {0, 0, []byte{0x30, 0x37, 0x30, 0x37, 0x30, 0x31}, nil, nil}, {0, 0, []byte{0x30, 0x37, 0x30, 0x37, 0x30, 0x32}, nil, nil}, {0, 0, []byte{0xc7, 0x71}, nil, nil}}},
+	{65, 55, []*magicMatch{{0, 256, []byte{0x3c, 0x73, 0x6d, 0x69, 0x6c}, nil, nil}}},
+	{569, 51, []*magicMatch{{0, 0, []byte{0x41, 0x53, 0x46, 0x20}, nil, nil}, {0, 64, []byte{0x3c, 0x41, 0x53, 0x58}, nil, nil}, {0, 64, []byte{0x3c, 0x61, 0x73, 0x78}, nil, nil}, {0, 64, []byte{0x3c, 0x41, 0x73, 0x78}, nil, nil}}},
+	{468, 50, []*magicMatch{{1, 0, []byte{0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x45, 0x20, 0x44, 0x27, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x41, 0x47, 0x45, 0x20, 0x50, 0x55, 0x4b, 0x41, 0x4c, 0x4c, 0x20, 0x53, 0x2e, 0x41, 0x2e, 0x50, 0x2e, 0x20, 0x28, 0x63, 0x29, 0x20, 0x41, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64, 0x72, 0x65, 0x20, 0x50, 0x55, 0x4b, 0x41, 0x4c, 0x4c, 0x20, 0x41, 0x76, 0x72, 0x69, 0x6c, 0x20, 0x31, 0x39, 0x39, 0x38}, nil, nil}}},
+	{22, 50, []*magicMatch{{0, 0, []byte{0x28, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x20, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}, {100, 156, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x72, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61}, nil, nil}, {10, 246, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x20, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x20, 0x20, 0x49, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x41, 0x53, 0x43, 0x49, 0x49, 0x20, 0x74, 0x65, 0x78, 0x74}, nil, nil}}},
+	{271, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x74, 0x74, 0x46, 0x6f, 0x6e, 0x74, 0x20, 0x73, 0x66, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x5c, 0x78, 0x30, 0x30, 0x5c, 0x78, 0x30, 0x31, 0x5c, 0x78, 0x30, 0x30, 0x5c, 0x78, 0x30, 0x30, 0x22, 0x20, 0x74, 0x74, 0x4c, 0x69, 0x62, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22}, nil, nil}}},
+	{871, 50, []*magicMatch{{0, 256, []byte{0x23, 0x2c, 0x20, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0xa, 0x6d, 0x73, 0x67, 0x69, 0x64, 0x20, 0x22, 0x22, 0xa, 0x6d, 0x73, 0x67, 0x73, 0x74, 0x72, 0x20, 0x22, 0x22, 0xa, 0x22, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x49, 0x64, 0x2d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a}, nil, nil}}},
+	{130, 50, []*magicMatch{{0, 0, []byte{0x50, 0x4b, 0x3, 0x4}, nil, []*magicMatch{{30, 0, []byte{0x6d, 0x69, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65}, nil, []*magicMatch{{38, 0, []byte{0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x6e, 0x64, 0x2e, 0x73, 0x75, 0x6e, 0x2e, 0x78, 0x6d, 0x6c, 0x2e, 0x62, 0x61, 0x73, 0x65}, nil, nil}}}}}}},
+	{874, 50, []*magicMatch{{0, 0, []byte{0x23, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x74, 0x68, 0x65, 0x2e, 0x66, 0x72, 0x65, 0x65, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72}, nil, nil}, {0, 0, []byte{0x23, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x72, 0x65, 0x65, 0x20, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x20, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x20, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72}, nil, nil}}},
+	{850, 50, []*magicMatch{{0, 0, []byte{0x25, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x4a, 0x61, 0x62, 0x52, 0x65, 0x66}, nil, nil}}},
+	{453, 50, []*magicMatch{{0, 0, []byte{0x2a, 0x2a, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x53, 0x51, 0x4c, 0x69, 0x74, 0x65}, nil, nil}}},
+	{388, 50, []*magicMatch{{0, 32, []byte{0x3c, 0x6e, 0x61, 0x75, 0x74, 0x69, 0x6c, 0x75, 0x73, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x6e, 0x61, 0x75, 0x74, 0x69, 0x6c, 0x75, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x6b}, nil, nil}}},
+	{391, 50, []*magicMatch{{35, 0, []byte{0x0}, nil, []*magicMatch{{0, 0, []byte{0x43, 0x4f, 0x50, 0x59, 0x52, 0x49, 0x47, 0x48, 0x54, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}, {0, 0, []byte{0x20, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x44, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}}}}},
+	{390, 50, []*magicMatch{{35, 0, []byte{0x10}, nil, []*magicMatch{{0, 0, []byte{0x43, 0x4f, 0x50, 0x59, 0x52, 0x49, 0x47, 0x48, 0x54, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}, {0, 0, []byte{0x20, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x44, 0x20, 0x42, 0x59, 0x20, 0x53, 0x4e, 0x4b, 0x20, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}}}}},
+	{490, 50, []*magicMatch{{0, 0, []byte{0x3c, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31, 0x22, 0x3e}, nil, []*magicMatch{{23, 1, []byte{0x3c, 0x56, 0x4d, 0x54, 0x65, 0x61, 0x6d, 0x3e}, nil, nil}}}}},
+	{377, 50, []*magicMatch{{0, 0, []byte{0x5b, 0x4d, 0x4b, 0x56, 0x54, 0x6f, 0x6f, 0x6c, 0x4e, 0x69, 0x78, 0x25, 0x32, 0x30, 0x47, 0x55, 0x49, 0x25, 0x32, 0x30, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5d}, nil, nil}}},
+	{103, 50, []*magicMatch{{2080, 0, []byte{0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x20, 0x45, 0x78, 0x63, 0x65, 0x6c, 0x20, 0x35, 0x2e, 0x30, 0x20, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}},
+	{38, 50, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{21, 50, []*magicMatch{{11, 0, []byte{0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x42, 0x69, 0x6e, 0x48, 0x65, 0x78}, nil, nil}}},
+	{36, 50, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}}},
+	{493, 50, []*magicMatch{{0, 0, []byte{0x3c, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31, 0x22, 0x3e}, nil, []*magicMatch{{23, 1, []byte{0x3c, 0x56, 0x4d, 0x3e}, nil, nil}}}}},
+	{275, 50, []*magicMatch{{260, 0, []byte{0xce, 0xed, 0x66, 0x66, 0xcc, 0xd, 0x0, 0xb, 0x3, 0x73, 0x0, 0x83, 0x0, 0xc, 0x0, 0xd, 0x0, 0x8, 0x11, 0x1f, 0x88, 0x89, 0x0, 0xe}, nil, []*magicMatch{{323, 0, []byte{0x0}, []byte{0x80}, nil}}}}},
+	{957, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6d, 0x6a, 0x70, 0x32}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
+	{610, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6a, 0x70, 0x78, 0x20}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
+	{609, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6a, 0x70, 0x6d, 0x20}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
+	{607, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0xc, 0x6a, 0x50, 0x20, 0x20, 0xd, 0xa, 0x87, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6a, 0x70, 0x32, 0x20}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
+	{258, 50, []*magicMatch{{0, 0, []byte{0x23, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x46, 0x6c, 0x74, 0x6b}, nil, nil}}},
+	{883, 50, []*magicMatch{{0, 256, []byte{0x2f, 0x65, 0x74, 0x63, 0x2f, 0x73, 0x79, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73}, nil, nil}, {0, 256, []byte{0x2a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72}, nil, []*magicMatch{{0, 256, []byte{0x3a, 0x49, 0x4e, 0x50, 0x55, 0x54}, nil, []*magicMatch{{0, 256, []byte{0x3a, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44}, nil, []*magicMatch{{0, 256, []byte{0x3a, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54}, nil, nil}}}}}}}, {0, 256, []byte{0x2d, 0x41, 0x20, 0x49, 0x4e, 0x50, 0x55, 0x54}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x41, 0x20, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x41, 0x20, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54}, nil, nil}}}}}, {0, 256, []byte{0x2d, 0x50, 0x20, 0x49, 0x4e, 0x50, 0x55, 0x54}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x50, 0x20, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44}, nil, []*magicMatch{{0, 256, []byte{0x2d, 0x50, 0x20, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54}, nil, nil}}}}}}},
+	{25, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x33, 0x2e, 0x30, 0x22}, nil, nil}}},
+	{488, 50, []*magicMatch{{0, 4096, []byte{0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6b, 0x65, 0x79, 0x53, 0x61, 0x66, 0x65, 0x20, 0x3d, 0x20, 0x22}, nil, nil}}},
+	{954, 50, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x69, 0x73, 0x68, 0x65, 0x61, 0x64, 0x0}, nil, []*magicMatch{{56, 456, []byte{0x43, 0x4d, 0x4d, 0x4c, 0x0, 0x0, 0x0, 0x0}, nil, nil}}}}}}},
+	{539, 50, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x69, 0x73, 0x68, 0x65, 0x61, 0x64, 0x0}, nil, []*magicMatch{{56, 456, []byte{0x43, 0x4d, 0x4d, 0x4c, 0x0, 0x0, 0x0, 0x0}, nil, nil}}}}}}},
+	{3, 50, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, []*magicMatch{{28, 0, []byte{0x66, 0x69, 0x73, 0x68, 0x65, 0x61, 0x64, 0x0}, nil, []*magicMatch{{56, 456, []byte{0x43, 0x4d, 0x4d, 0x4c, 0x0, 0x0, 0x0, 0x0}, nil, nil}}}}}}},
+	{532, 50, []*magicMatch{{0, 64, []byte{0x3c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x31}, nil, nil}, {0, 64, []byte{0x3c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3d, 0x27, 0x31}, nil, nil}}},
+	{589, 50, []*magicMatch{{0, 0, []byte{0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x20, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x3a}, nil, nil}}},
+	{489, 50, []*magicMatch{{0, 0, []byte{0x5c, 0x30, 0x78, 0x44, 0x30, 0x5c, 0x30, 0x78, 0x42, 0x45, 0x5c, 0x30, 0x78, 0x44, 0x30, 0x5c, 0x30, 0x78, 0x42, 0x45}, nil, nil}}},
+	{451, 50, []*magicMatch{{40, 0, []byte{0x41, 0x53, 0x43, 0x49, 0x49, 0x20, 0x53, 0x50, 0x53, 0x53, 0x20, 0x50, 0x4f, 0x52, 0x54, 0x20, 0x46, 0x49, 0x4c, 0x45}, nil, nil}}},
+	{26, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x3d, 0x22, 0x75, 0x72, 0x6e}, nil, nil}}},
+	{274, 50, []*magicMatch{{260, 0, []byte{0xce, 0xed, 0x66, 0x66, 0xcc, 0xd, 0x0, 0xb, 0x3, 0x73, 0x0, 0x83, 0x0, 0xc, 0x0, 0xd, 0x0, 0x8}, nil, []*magicMatch{{323, 0, []byte{0x80}, []byte{0x80}, nil}}}}},
+	{100, 50, []*magicMatch{{0, 0, []byte{0x0, 0x1, 0x0, 0x0, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x20, 0x4a, 0x65, 0x74, 0x20, 0x44, 0x42}, nil, nil}}},
+	{201, 50, []*magicMatch{{0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x53, 0x50, 0x52, 0x45, 0x41, 0x44, 0x53, 0x48, 0x45, 0x45, 0x54, 0x53}, nil, nil}, {0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e}, nil, []*magicMatch{{7, 0, []byte{0x53, 0x50, 0x52, 0x45, 0x41, 0x44, 0x53, 0x48, 0x45, 0x45, 0x54, 0x53}, nil, nil}}}}},
+	{491, 50, []*magicMatch{{0, 4096, []byte{0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x20, 0x22}, nil, nil}}},
+	{298, 50, []*magicMatch{{0, 0, []byte{0x48, 0x57, 0x50, 0x20, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x46, 0x69, 0x6c, 0x65}, nil, nil}}},
+	{861, 50, []*magicMatch{{0, 256, []byte{0xa, 0x5b, 0x44, 0x2d, 0x42, 0x55, 0x53, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x44, 0x2d, 0x42, 0x55, 0x53, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}}},
+	{644, 50, []*magicMatch{{0, 0, []byte{0x46, 0x55, 0x4a, 0x49, 0x46, 0x49, 0x4c, 0x4d, 0x43, 0x43, 0x44, 0x2d, 0x52, 0x41, 0x57, 0x20}, nil, nil}}},
+	{590, 50, []*magicMatch{{0, 0, []byte{0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x20, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a}, nil, nil}}},
+	{284, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x67, 0x6c, 0x61, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65}, nil, nil}}},
+	{262, 50, []*magicMatch{{0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x46, 0x6f, 0x6e, 0x74}, nil, nil}}},
+	{818, 50, []*magicMatch{{0, 0, []byte{0x43, 0x41, 0x43, 0x48, 0x45, 0x20, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54}, nil, []*magicMatch{{14, 0, []byte{0x20}, nil, nil}, {14, 0, []byte{0x9}, nil, nil}, {14, 0, []byte{0xa}, nil, nil}, {14, 0, []byte{0xd}, nil, nil}}}}},
+	{384, 50, []*magicMatch{{1, 0, []byte{0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74}, nil, nil}, {1, 0, []byte{0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54}, nil, []*magicMatch{{11, 0, []byte{0x42, 0x41, 0x53, 0x45, 0x55, 0x52, 0x4c, 0x3d}, nil, nil}}}}},
+	{891, 50, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x6b, 0x65}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x6b, 0x65}, nil, nil}}},
+	{819, 50, []*magicMatch{{0, 0, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x3a, 0x56, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52}, nil, nil}, {0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x3a, 0x76, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72}, nil, nil}}},
+	{432, 50, []*magicMatch{{0, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x53, 0x45, 0x47, 0x41, 0x53, 0x41, 0x54, 0x55, 0x52, 0x4e}, nil, nil}, {16, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x53, 0x45, 0x47, 0x41, 0x53, 0x41, 0x54, 0x55, 0x52, 0x4e}, nil, nil}}},
+	{229, 50, []*magicMatch{{0, 0, []byte{0x5b, 0x6d, 0x61, 0x69, 0x6e, 0x5d}, nil, []*magicMatch{{0, 256, []byte{0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x3d}, nil, nil}}}}},
+	{531, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x78, 0x73, 0x6c, 0x3a, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}},
+	{162, 50, []*magicMatch{{0, 0, []byte{0x53, 0x51, 0x4c, 0x69, 0x74, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x33}, nil, nil}}},
+	{600, 50, []*magicMatch{{0, 0, []byte{0x1, 0x0, 0x0, 0x0}, nil, []*magicMatch{{40, 0, []byte{0x20, 0x45, 0x4d, 0x46}, nil, []*magicMatch{{44, 0, []byte{0x0, 0x0, 0x1, 0x0}, nil, []*magicMatch{{58, 0, []byte{0x0, 0x0}, nil, nil}}}}}}}}},
+	{374, 50, []*magicMatch{{0, 0, []byte{0x1a, 0x45, 0xdf, 0xa3}, nil, []*magicMatch{{5, 60, []byte{0x42, 0x82}, nil, []*magicMatch{{8, 67, []byte{0x6d, 0x61, 0x74, 0x72, 0x6f, 0x73, 0x6b, 0x61}, nil, nil}}}}}}},
+	{632, 50, []*magicMatch{{0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e}, nil, []*magicMatch{{7, 0, []byte{0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x53}, nil, nil}}}}},
+	{635, 50, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x1a, 0x0, 0x0, 0x0, 0x48, 0x45, 0x41, 0x50, 0x43, 0x43, 0x44, 0x52}, nil, nil}}},
+	{560, 50, []*magicMatch{{4, 0, []byte{0x69, 0x72, 0x69, 0x76, 0x65, 0x72, 0x20, 0x55, 0x4d, 0x53, 0x20, 0x50, 0x4c, 0x41}, nil, nil}}},
+	{516, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x78, 0x62, 0x65, 0x6c}, nil, nil}}},
+	{256, 50, []*magicMatch{{1, 0, []byte{0x2a, 0x4e, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x44, 0x4f, 0x2d, 0x48, 0x56, 0x43, 0x2a}, nil, nil}}},
+	{97, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x2, 0x0, 0x6, 0x4, 0x6, 0x0, 0x8, 0x0, 0x0, 0x0, 0x0, 0x0}, nil, nil}}},
+	{81, 50, []*magicMatch{{0, 256, []byte{0x5b, 0x46, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x20, 0x52, 0x65, 0x70, 0x6f, 0x5d}, nil, nil}}},
+	{29, 50, []*magicMatch{{0, 256, []byte{0x6, 0xe, 0x2b, 0x34, 0x2, 0x5, 0x1, 0x1, 0xd, 0x1, 0x2, 0x1, 0x1, 0x2}, nil, nil}}},
+	{913, 50, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e}, nil, nil}}},
+	{243, 50, []*magicMatch{{0, 32, []byte{0x5b, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x20, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x5d}, nil, nil}, {0, 0, []byte{0x5b, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x5b, 0x4b, 0x44, 0x45, 0x20, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x20, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x5d}, nil, nil}, {0, 0, []byte{0x23, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x23, 0x20, 0x4b, 0x44, 0x45, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x46, 0x69, 0x6c, 0x65}, nil, nil}}},
+	{77, 50, []*magicMatch{{0, 0, []byte{0x21, 0x3c, 0x61, 0x72, 0x63, 0x68, 0x3e}, nil, []*magicMatch{{8, 0, []byte{0x64, 0x65, 0x62, 0x69, 0x61, 0x6e}, nil, nil}}}}},
+	{933, 50, []*magicMatch{{1, 0, []byte{0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73}, nil, nil}}},
+	{928, 50, []*magicMatch{{0, 0, []byte{0x5b, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5d}, nil, nil}}},
+	{879, 50, []*magicMatch{{0, 0, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x3a, 0x49, 0x4d, 0x45, 0x4c, 0x4f, 0x44, 0x59}, nil, nil}}},
+	{80, 50, []*magicMatch{{0, 256, []byte{0x5b, 0x46, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x20, 0x52, 0x65, 0x66, 0x5d}, nil, nil}}},
+	{827, 50, []*magicMatch{{0, 0, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x54, 0x65, 0x58, 0x2c}, nil, nil}, {0, 0, []byte{0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x4d, 0x45, 0x54, 0x41, 0x46, 0x4f, 0x4e, 0x54, 0x2c}, nil, nil}}},
+	{286, 50, []*magicMatch{{0, 64, []byte{0x67, 0x6d, 0x72, 0x3a, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b}, nil, nil}, {0, 64, []byte{0x67, 0x6e, 0x6d, 0x3a, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b}, nil, nil}}},
+	{79, 50, []*magicMatch{{0, 0, []byte{0x78, 0x64, 0x67, 0x2d, 0x61, 0x70, 0x70, 0x0, 0x1, 0x0, 0x89, 0xe5}, nil, nil}, {0, 0, []byte{0x66, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x0, 0x1, 0x0, 0x89, 0xe5}, nil, nil}}},
+	{82, 50, []*magicMatch{{0, 0, []byte{0x53, 0x70, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x44, 0x42}, nil, nil}}},
+	{836, 50, []*magicMatch{{0, 0, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x3a, 0x56, 0x43, 0x41, 0x52, 0x44}, nil, nil}, {0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x3a, 0x76, 0x63, 0x61, 0x72, 0x64}, nil, nil}}},
+	{202, 50, []*magicMatch{{0, 0, []byte{0x2a, 0x42, 0x45, 0x47, 0x49, 0x4e}, nil, []*magicMatch{{7, 0, []byte{0x57, 0x4f, 0x52, 0x44, 0x53}, nil, nil}}}}},
+	{433, 50, []*magicMatch{{38, 0, []byte{0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74}, nil, nil}}},
+	{213, 50, []*magicMatch{{0, 0, []byte{0x64, 0x38, 0x3a, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65}, nil, nil}}},
+	{6, 50, []*magicMatch{{0, 0, []byte{0x78, 0x62, 0x74, 0x6f, 0x61, 0x20, 0x42, 0x65, 0x67, 0x69, 0x6e}, nil, nil}}},
+	{211, 50, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x6b}, nil, nil}}},
+	{16, 50, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x67, 0x6a, 0x73}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x67, 0x6a, 0x73}, nil, nil}}},
+	{968, 50, []*magicMatch{{0, 0, []byte{0x1a, 0x45, 0xdf, 0xa3}, nil, []*magicMatch{{5, 60, []byte{0x42, 0x82}, nil, []*magicMatch{{8, 67, []byte{0x77, 0x65, 0x62, 0x6d}, nil, nil}}}}}}},
+	{579, 50, []*magicMatch{{0, 0, []byte{0x5b, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5d}, nil, nil}, {0, 0, []byte{0x5b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5d}, nil, nil}, {0, 0, []byte{0x5b, 0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x5d}, nil, nil}}},
+	{927, 50, []*magicMatch{{0, 256, []byte{0x5b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x5d}, nil, nil}, {0, 256, []byte{0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x3a, 0x20}, nil, nil}}},
+	{691, 50, []*magicMatch{{0, 0, []byte{0x67, 0x69, 0x6d, 0x70, 0x20, 0x78, 0x63, 0x66, 0x20, 0x66, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x67, 0x69, 0x6d, 0x70, 0x20, 0x78, 0x63, 0x66, 0x20, 0x76}, nil, nil}}},
+	{618, 50, []*magicMatch{{0, 0, []byte{0x38, 0x42, 0x50, 0x53, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, []byte{0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0xff, 0xff, 0xff, 0xff}, nil}}},
+	{290, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65}, nil, nil}}},
+	{260, 50, []*magicMatch{{0, 0, []byte{0x53, 0x54, 0x41, 0x52, 0x54, 0x46, 0x4f, 0x4e, 0x54, 0x20}, nil, nil}}},
+	{582, 50, []*magicMatch{{20, 0, []byte{0x21, 0x53, 0x63, 0x72, 0x65, 0x61, 0x6d, 0x21, 0x1a}, nil, nil}, {20, 0, []byte{0x21, 0x53, 0x43, 0x52, 0x45, 0x41, 0x4d, 0x21, 0x1a}, nil, nil}, {20, 0, []byte{0x42, 0x4d, 0x4f, 0x44, 0x32, 0x53, 0x54, 0x4d, 0x1a}, nil, nil}}},
+	{806, 50, []*magicMatch{{72, 0, []byte{0x53, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x31, 0xa}, nil, nil}, {72, 0, []byte{0x53, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0xa}, nil, nil}}},
+	{536, 50, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0x2d, 0x57, 0x42, 0xa}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0x2d, 0x57, 0x42, 0x5f, 0x4d, 0x43, 0x31, 0x2e, 0x30, 0xa}, nil, nil}}},
+	{430, 50, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x72, 0x75, 0x62, 0x79}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x72, 0x75, 0x62, 0x79}, nil, nil}}},
+	{304, 50, []*magicMatch{{0, 0, []byte{0x7b}, nil, []*magicMatch{{1, 255, []byte{0x22, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x3a}, nil, nil}}}}},
+	{602, 50, []*magicMatch{{0, 0, []byte{0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x20, 0x20, 0x3d}, nil, nil}}},
+	{435, 50, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x50, 0x49, 0x43, 0x4f}, nil, nil}}},
+	{394, 50, []*magicMatch{{0, 0, []byte{0x5b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5d}, nil, nil}}},
+	{291, 50, []*magicMatch{{4, 0, []byte{0x67, 0x74, 0x6b, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x20}, nil, nil}}},
+	{208, 50, []*magicMatch{{1, 0, []byte{0x41, 0x54, 0x41, 0x52, 0x49, 0x37, 0x38, 0x30, 0x30}, nil, nil}}},
+	{33, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x4f, 0x6e, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x79}, nil, nil}}},
+	{553, 50, []*magicMatch{{0, 0, []byte{0x2e, 0x73, 0x6e, 0x64}, nil, []*magicMatch{{12, 0, []byte{0x0, 0x0, 0x0, 0x17}, nil, nil}}}, {0, 0, []byte{0x2e, 0x73, 0x64, 0x0}, nil, []*magicMatch{{12, 0, []byte{0x1, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x2, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x3, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x4, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x5, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x6, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x7, 0x0, 0x0, 0x0}, nil, nil}, {12, 0, []byte{0x17, 0x0, 0x0, 0x0}, nil, nil}}}}},
+	{960, 50, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x69, 0x73, 0x6f, 0x6d}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x6d, 0x70, 0x34, 0x32}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x53, 0x4e, 0x56}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x34, 0x56, 0x20}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x66, 0x34, 0x76, 0x20}, nil, nil}}},
+	{979, 50, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41, 0x56, 0x49, 0x20}, nil, nil}}}, {0, 0, []byte{0x41, 0x56, 0x46, 0x30}, nil, []*magicMatch{{8, 0, []byte{0x41, 0x56, 0x49, 0x20}, nil, nil}}}}},
+	{890, 50, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6c, 0x75, 0x61}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6c, 0x75, 0x61, 0x6a, 0x69, 0x74}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x6c, 0x75, 0x61}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x6c, 0x75, 0x61, 0x6a, 0x69, 0x74}, nil, nil}}},
+	{671, 50, []*magicMatch{{522, 0, []byte{0x0, 0x11}, nil, []*magicMatch{{524, 0, []byte{0x2, 0xff}, nil, []*magicMatch{{526, 0, []byte{0xc, 0x0}, nil, []*magicMatch{{528, 0, []byte{0xff, 0xfe}, nil, nil}}}}}}}}},
+	{671, 50, []*magicMatch{{10, 0, []byte{0x0, 0x11}, nil, []*magicMatch{{12, 0, []byte{0x2, 0xff}, nil, []*magicMatch{{14, 0, []byte{0xc, 0x0}, nil, []*magicMatch{{16, 0, []byte{0xff, 0xfe}, nil, nil}}}}}}}}},
+	{237, 50, []*magicMatch{{2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x63, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x63, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x74, 0x63, 0x73, 0x68}, nil, nil}}},
+	{918, 50, []*magicMatch{{0, 0, []byte{0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x3a, 0x20}, nil, nil}, {0, 0, []byte{0x25, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x20}, nil, nil}}},
+	{679, 50, []*magicMatch{{0, 0, []byte{0x46, 0x4f, 0x56, 0x62}, nil, []*magicMatch{{4, 0, []byte{0xff, 0x0, 0xff, 0x0}, []byte{0x0, 0xff, 0x0, 0xff}, nil}}}}},
+	{628, 50, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x57, 0x45, 0x42, 0x50}, nil, nil}}}}},
+	{622, 50, []*magicMatch{{0, 64, []byte{0xa, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0xa}, nil, nil}, {0, 64, []byte{0xd, 0xa, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0xd, 0xa}, nil, nil}}},
+	{389, 50, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41, 0x43, 0x4f, 0x4e}, nil, nil}}}}},
+	{215, 50, []*magicMatch{{0, 0, []byte{0x42, 0x53, 0x44, 0x49, 0x46, 0x46, 0x34, 0x30}, nil, nil}, {0, 0, []byte{0x42, 0x53, 0x44, 0x49, 0x46, 0x4e, 0x34, 0x30}, nil, nil}}},
+	{199, 50, []*magicMatch{{60, 0, []byte{0x54, 0x45, 0x58, 0x74, 0x52, 0x45, 0x41, 0x64}, nil, nil}, {60, 0, []byte{0x54, 0x45, 0x58, 0x74, 0x54, 0x6c, 0x44, 0x63}, nil, nil}}},
+	{192, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x61, 0x62, 0x69, 0x77, 0x6f, 0x72, 0x64}, nil, nil}, {0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x61, 0x62, 0x69, 0x77, 0x6f, 0x72, 0x64}, nil, nil}}},
+	{976, 50, []*magicMatch{{0, 0, []byte{0x8a, 0x4d, 0x4e, 0x47, 0xd, 0xa, 0x1a, 0xa}, nil, nil}}},
+	{897, 50, []*magicMatch{{0, 0, []byte{0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}}},
+	{892, 50, []*magicMatch{{0, 0, []byte{0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e}, nil, nil}}},
+	{680, 50, []*magicMatch{{0, 0, []byte{0x23, 0x23, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68}, nil, nil}}},
+	{667, 50, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x55, 0x0, 0x18, 0x0, 0x0, 0x0}, nil, nil}}},
+	{666, 50, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x55, 0x0, 0x8, 0x0, 0x0, 0x0}, nil, nil}}},
+	{665, 50, []*magicMatch{{0, 0, []byte{0x49, 0x49, 0x52, 0x4f, 0x8, 0x0, 0x0, 0x0}, nil, nil}}},
+	{382, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x53, 0x57, 0x49, 0x4d, 0x0, 0x0, 0x0}, nil, nil}}},
+	{297, 50, []*magicMatch{{0, 0, []byte{0x48, 0x58, 0x43, 0x50, 0x49, 0x43, 0x46, 0x45}, nil, nil}}},
+	{281, 50, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x33, 0x32, 0x58}, nil, nil}}},
+	{12, 50, []*magicMatch{{0, 0, []byte{0x89, 0x47, 0x4e, 0x44, 0xd, 0xa, 0x1a, 0xa}, nil, nil}}},
+	{931, 50, []*magicMatch{{0, 256, []byte{0xa, 0x5b, 0x55, 0x6e, 0x69, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x50, 0x61, 0x74, 0x68, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x53, 0x77, 0x61, 0x70, 0x5d, 0xa}, nil, nil}, {0, 256, []byte{0xa, 0x5b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x55, 0x6e, 0x69, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x50, 0x61, 0x74, 0x68, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x53, 0x77, 0x61, 0x70, 0x5d, 0xa}, nil, nil}, {0, 0, []byte{0x5b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x5d, 0xa}, nil, nil}}},
+	{440, 50, []*magicMatch{{10, 0, []byte{0x23, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6e, 0x61, 0x77, 0x6b}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x7a, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x6b, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x64, 0x61, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x62, 0x61, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x7a, 0x73, 0x68}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x6b, 0x73, 0x68}, nil, nil}}},
+	{409, 50, []*magicMatch{{0, 0, []byte{0x65, 0x76, 0x61, 0x6c, 0x20, 0x22, 0x65, 0x78, 0x65, 0x63, 0x20, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x65, 0x72, 0x6c}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x70, 0x65, 0x72, 0x6c}, nil, nil}, {2, 14, []byte{0x2f, 0x62, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x76, 0x20, 0x70, 0x65, 0x72, 0x6c}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73}, nil, nil}, {0, 256, []byte{0x75, 0x73, 0x65, 0x20, 0x54, 0x65, 0x73, 0x74, 0x3a, 0x3a}, nil, nil}, {0, 256, []byte{0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x7b}, nil, nil}}},
+	{397, 50, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x1, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x1}, nil, nil}}}}}}},
+	{952, 50, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x65}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x67}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x70}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x73}, nil, nil}}},
+	{901, 50, []*magicMatch{{0, 0, []byte{0x52, 0x45, 0x47, 0x45, 0x44, 0x49, 0x54}, nil, nil}, {0, 0, []byte{0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x20, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x20, 0x45, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x35, 0x2e, 0x30, 0x30}, nil, nil}, {0, 0, []byte{0xff, 0xfe, 0x57, 0x0, 0x69, 0x0, 0x6e, 0x0, 0x64, 0x0, 0x6f, 0x0, 0x77, 0x0, 0x73, 0x0, 0x20, 0x0, 0x52, 0x0, 0x65, 0x0, 0x67, 0x0, 0x69, 0x0, 0x73, 0x0, 0x74, 0x0, 0x72, 0x0, 0x79, 0x0, 0x20, 0x0, 0x45, 0x0, 0x64, 0x0, 0x69, 0x0, 0x74, 0x0, 0x6f, 0x0, 0x72, 0x0}, nil, nil}}},
+	{886, 50, []*magicMatch{{0, 0, []byte{0x64, 0x6e, 0x3a, 0x20, 0x63, 0x6e, 0x3d}, nil, nil}, {0, 0, []byte{0x64, 0x6e, 0x3a, 0x20, 0x6d, 0x61, 0x69, 0x6c, 0x3d}, nil, nil}}},
+	{288, 50, []*magicMatch{{0, 0, []byte{0x28, 0x3b, 0x46, 0x46, 0x5b, 0x33, 0x5d}, nil, nil}, {0, 0, []byte{0x28, 0x3b, 0x46, 0x46, 0x5b, 0x34, 0x5d}, nil, nil}}},
+	{964, 50, []*magicMatch{{0, 0, []byte{0x23, 0x45, 0x58, 0x54, 0x4d, 0x34, 0x55}, nil, nil}}},
+	{953, 50, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x33, 0x67, 0x32}, nil, nil}}},
+	{899, 50, []*magicMatch{{0, 256, []byte{0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x3d}, nil, nil}}},
+	{841, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x49, 0x44, 0x6c, 0x65, 0x74, 0x2d}, nil, nil}}},
+	{568, 50, []*magicMatch{{0, 0, []byte{0x23, 0x45, 0x58, 0x54, 0x4d, 0x33, 0x55}, nil, nil}}},
+	{562, 50, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x34, 0x42}, nil, nil}}},
+	{544, 50, []*magicMatch{{4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x4d, 0x34, 0x41}, nil, nil}}},
+	{419, 50, []*magicMatch{{0, 0, []byte{0x51, 0x74, 0x69, 0x50, 0x6c, 0x6f, 0x74}, nil, nil}}},
+	{303, 50, []*magicMatch{{0, 0, []byte{0x53, 0x20, 0x54, 0x20, 0x4f, 0x20, 0x50}, nil, nil}}},
+	{214, 50, []*magicMatch{{0, 0, []byte{0x42, 0x4c, 0x45, 0x4e, 0x44, 0x45, 0x52}, nil, nil}}},
+	{98, 50, []*magicMatch{{0, 0, []byte{0x57, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f}, nil, nil}}},
+	{78, 50, []*magicMatch{{0, 0, []byte{0x6e, 0x46, 0x37, 0x59, 0x4c, 0x61, 0x6f}, nil, nil}}},
+	{72, 50, []*magicMatch{{0, 0, []byte{0x5b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x20}, nil, nil}}},
+	{57, 50, []*magicMatch{{0, 0, []byte{0x23, 0x25, 0x52, 0x41, 0x4d, 0x4c, 0x20}, nil, nil}}},
+	{228, 50, []*magicMatch{{0, 0, []byte{0x43, 0x44, 0x5f, 0x52, 0x4f, 0x4d, 0xa}, nil, nil}, {0, 0, []byte{0x43, 0x44, 0x5f, 0x44, 0x41, 0xa}, nil, nil}, {0, 0, []byte{0x43, 0x44, 0x5f, 0x52, 0x4f, 0x4d, 0x5f, 0x58, 0x41, 0xa}, nil, nil}, {0, 0, []byte{0x43, 0x44, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x20}, nil, nil}, {0, 0, []byte{0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x20, 0x22}, nil, []*magicMatch{{22, 0, []byte{0x22}, nil, nil}}}}},
+	{838, 50, []*magicMatch{{0, 0, []byte{0x64, 0x69, 0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}, {0, 0, []byte{0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x64, 0x69, 0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}, {0, 0, []byte{0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}, {0, 0, []byte{0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x67, 0x72, 0x61, 0x70, 0x68, 0x20}, nil, nil}}},
+	{305, 50, []*magicMatch{{1, 0, []byte{0x45, 0x4c, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41}, nil, []*magicMatch{{9, 0, []byte{0x49}, nil, []*magicMatch{{10, 0, []byte{0x1}, nil, nil}}}}}}}}},
+	{70, 50, []*magicMatch{{1, 0, []byte{0x45, 0x4c, 0x46}, nil, []*magicMatch{{8, 0, []byte{0x41}, nil, []*magicMatch{{9, 0, []byte{0x49}, nil, []*magicMatch{{10, 0, []byte{0x2}, nil, nil}}}}}}}}},
+	{535, 50, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0xa}, nil, nil}, {0, 0, []byte{0x23, 0x21, 0x41, 0x4d, 0x52, 0x5f, 0x4d, 0x43, 0x31, 0x2e, 0x30, 0xa}, nil, nil}}},
+	{455, 50, []*magicMatch{{0, 0, []byte{0x31}, nil, []*magicMatch{{0, 256, []byte{0x20, 0x2d, 0x2d, 0x3e, 0x20}, nil, nil}}}}},
+	{348, 50, []*magicMatch{{4, 0, []byte{0x4b, 0x53, 0x79, 0x73, 0x56}, nil, []*magicMatch{{15, 0, []byte{0x1}, nil, nil}}}}},
+	{951, 50, []*magicMatch{{0, 0, []byte{0x23, 0x20, 0x78, 0x6d, 0x63, 0x64}, nil, nil}}},
+	{942, 50, []*magicMatch{{0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x20}, nil, nil}}},
+	{902, 50, []*magicMatch{{0, 0, []byte{0x2f, 0x2f, 0x21, 0x4d, 0x75, 0x70}, nil, nil}}},
+	{900, 50, []*magicMatch{{0, 0, []byte{0x3c, 0x6d, 0x72, 0x6d, 0x6c, 0x20}, nil, nil}}},
+	{897, 50, []*magicMatch{{0, 0, []byte{0x72, 0x65, 0x63, 0x6f, 0x72, 0x64}, nil, nil}}},
+	{844, 50, []*magicMatch{{0, 0, []byte{0x57, 0x45, 0x42, 0x56, 0x54, 0x54}, nil, nil}}},
+	{808, 50, []*magicMatch{{0, 0, []byte{0x23, 0x56, 0x52, 0x4d, 0x4c, 0x20}, nil, nil}}},
+	{694, 50, []*magicMatch{{0, 0, []byte{0x2f, 0x2a, 0x20, 0x58, 0x50, 0x4d}, nil, nil}}},
+	{685, 50, []*magicMatch{{0, 0, []byte{0x56, 0x43, 0x4c, 0x4d, 0x54, 0x46}, nil, nil}}},
+	{431, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x53, 0x41, 0x4d, 0x49, 0x3e}, nil, nil}}},
+	{280, 50, []*magicMatch{{0, 0, []byte{0x30, 0x20, 0x48, 0x45, 0x41, 0x44}, nil, nil}}},
+	{245, 50, []*magicMatch{{5, 95, []byte{0x3c, 0x73, 0x68, 0x61, 0x70, 0x65}, nil, nil}}},
+	{959, 50, []*magicMatch{{0, 0, []byte{0x47}, nil, []*magicMatch{{188, 0, []byte{0x47}, nil, []*magicMatch{{376, 0, []byte{0x47}, nil, []*magicMatch{{564, 0, []byte{0x47}, nil, []*magicMatch{{752, 0, []byte{0x47}, nil, nil}}}}}}}}}, {4, 0, []byte{0x47}, nil, []*magicMatch{{196, 0, []byte{0x47}, nil, []*magicMatch{{388, 0, []byte{0x47}, nil, []*magicMatch{{580, 0, []byte{0x47}, nil, []*magicMatch{{772, 0, []byte{0x47}, nil, nil}}}}}}}}}}},
+	{804, 50, []*magicMatch{{0, 0, []byte{0x23, 0x21, 0x20, 0x72, 0x6e, 0x65, 0x77, 0x73}, nil, nil}, {0, 0, []byte{0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x20, 0x74, 0x6f}, nil, nil}, {0, 0, []byte{0x46, 0x72, 0x6f, 0x6d, 0x3a}, nil, nil}, {0, 0, []byte{0x4e, 0x23, 0x21, 0x20, 0x72, 0x6e, 0x65, 0x77, 0x73}, nil, nil}, {0, 0, []byte{0x50, 0x69, 0x70, 0x65, 0x20, 0x74, 0x6f}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x3a}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x6c, 0x61, 0x79, 0x2d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x50, 0x61, 0x74, 0x68, 0x3a}, nil, nil}, {0, 0, []byte{0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x3a}, nil, nil}, {0, 0, []byte{0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x3a, 0x20}, nil, nil}}},
+	{234, 50, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4}, []byte{0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff}, nil}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x4, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x4}, nil, nil}}}}}, {0, 0, []byte{0x43, 0x6f, 0x72, 0x65, 0x1}, nil, nil}, {0, 0, []byte{0x43, 0x6f, 0x72, 0x65, 0x2}, nil, nil}}},
+	{802, 50, []*magicMatch{{0, 0, []byte{0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x50, 0x61, 0x74, 0x68, 0x3a}, nil, nil}, {0, 0, []byte{0x58, 0x72, 0x65, 0x66, 0x3a}, nil, nil}}},
+	{459, 50, []*magicMatch{{0, 0, []byte{0x40, 0x43, 0x54, 0x20, 0x30}, nil, nil}, {0, 0, []byte{0x40, 0x43, 0x54, 0x20, 0x31}, nil, nil}, {0, 0, []byte{0x40, 0x43, 0x54, 0x20, 0x32}, nil, nil}}},
+	{942, 50, []*magicMatch{{0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e}, nil, nil}, {0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e, 0x2d, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34}, nil, nil}}},
+	{807, 50, []*magicMatch{{0, 0, []byte{0x73, 0x6f, 0x6c, 0x69, 0x64}, nil, nil}, {0, 0, []byte{0x53, 0x4f, 0x4c, 0x49, 0x44}, nil, nil}}},
+	{689, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x2, 0x0}, nil, []*magicMatch{{5, 0, []byte{0x0}, nil, nil}}}}},
+	{623, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x1, 0x0}, nil, []*magicMatch{{5, 0, []byte{0x0}, nil, nil}}}}},
+	{949, 50, []*magicMatch{{0, 0, []byte{0x62, 0x65, 0x67, 0x69, 0x6e}, nil, nil}}},
+	{897, 50, []*magicMatch{{0, 0, []byte{0x6d, 0x6f, 0x64, 0x65, 0x6c}, nil, nil}}},
+	{897, 50, []*magicMatch{{0, 0, []byte{0x63, 0x6c, 0x61, 0x73, 0x73}, nil, nil}}},
+	{847, 50, []*magicMatch{{0, 0, []byte{0x24, 0x6c, 0x74, 0x3b, 0x7e}, nil, nil}}},
+	{580, 50, []*magicMatch{{0, 0, []byte{0x53, 0x70, 0x65, 0x65, 0x78}, nil, nil}}},
+	{521, 50, []*magicMatch{{0, 0, []byte{0x25, 0x59, 0x41, 0x4d, 0x4c}, nil, nil}}},
+	{464, 50, []*magicMatch{{0, 0, []byte{0x25, 0x54, 0x47, 0x49, 0x46}, nil, nil}}},
+	{414, 50, []*magicMatch{{0, 0, []byte{0x7b, 0x5c, 0x70, 0x77, 0x69}, nil, nil}}},
+	{312, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x6a, 0x6e, 0x6c, 0x70}, nil, nil}}},
+	{306, 50, []*magicMatch{{0, 0, []byte{0x49, 0x54, 0x38, 0x2e, 0x37}, nil, nil}}},
+	{267, 50, []*magicMatch{{0, 0, []byte{0x44, 0x31, 0x2e, 0x30, 0xd}, nil, nil}}},
+	{244, 50, []*magicMatch{{5, 95, []byte{0x3c, 0x64, 0x69, 0x61, 0x3a}, nil, nil}}},
+	{62, 50, []*magicMatch{{0, 0, []byte{0x7b, 0x5c, 0x72, 0x74, 0x66}, nil, nil}}},
+	{35, 50, []*magicMatch{{0, 1024, []byte{0x25, 0x50, 0x44, 0x46, 0x2d}, nil, nil}}},
+	{912, 50, []*magicMatch{{0, 0, []byte{0x64, 0x69, 0x66, 0x66, 0x9}, nil, nil}, {0, 0, []byte{0x64, 0x69, 0x66, 0x66, 0x20}, nil, nil}, {0, 0, []byte{0x2a, 0x2a, 0x2a, 0x9}, nil, nil}, {0, 0, []byte{0x2a, 0x2a, 0x2a, 0x20}, nil, nil}, {0, 0, []byte{0x3d, 0x3d, 0x3d, 0x20}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x20}, nil, nil}, {0, 0, []byte{0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x69, 0x6e, 0x9}, nil, nil}, {0, 0, []byte{0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x69, 0x6e, 0x20}, nil, nil}, {0, 0, []byte{0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x20, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x20}, nil, nil}, {0, 0, []byte{0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a}, nil, nil}}},
+	{439, 50, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x3, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x3}, nil, nil}}}}}, {0, 0, []byte{0x83, 0x1}, nil, []*magicMatch{{22, 0, []byte{0x0, 0x20}, []byte{0x0, 0x30}, nil}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, []byte{0xff, 0xff, 0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xff}, nil}}},
+	{83, 50, []*magicMatch{{0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x49, 0x46, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x46, 0x6f, 0x6e}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x4d, 0x4c}, nil, nil}, {0, 0, []byte{0x3c, 0x42, 0x6f, 0x6f, 0x6b}, nil, nil}, {0, 0, []byte{0x3c, 0x4d, 0x61, 0x6b, 0x65, 0x72}, nil, nil}}},
+	{629, 50, []*magicMatch{{0, 0, []byte{0xd7, 0xcd, 0xc6, 0x9a}, nil, []*magicMatch{{22, 0, []byte{0x1, 0x0}, nil, []*magicMatch{{24, 0, []byte{0x9, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x1, 0x0}, nil, []*magicMatch{{2, 0, []byte{0x9, 0x0}, nil, nil}}}}},
+	{963, 50, []*magicMatch{{12, 0, []byte{0x6d, 0x64, 0x61, 0x74}, nil, nil}, {4, 0, []byte{0x6d, 0x64, 0x61, 0x74}, nil, nil}, {4, 0, []byte{0x6d, 0x6f, 0x6f, 0x76}, nil, nil}, {4, 0, []byte{0x66, 0x74, 0x79, 0x70, 0x71, 0x74}, nil, nil}}},
+	{549, 50, []*magicMatch{{0, 0, []byte{0x7f, 0xfe, 0x80, 0x1}, nil, nil}, {0, 0, []byte{0x80, 0x1, 0x7f, 0xfe}, nil, nil}, {0, 0, []byte{0x1f, 0xff, 0xe8, 0x0}, nil, nil}, {0, 0, []byte{0xe8, 0x0, 0x1f, 0xff}, nil, nil}}},
+	{282, 50, []*magicMatch{{256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53}, nil, nil}, {256, 0, []byte{0x53, 0x45, 0x47, 0x41, 0x20, 0x4d, 0x45, 0x47, 0x41, 0x20, 0x44, 0x52, 0x49, 0x56, 0x45}, nil, nil}, {640, 0, []byte{0x45, 0x41, 0x47, 0x4e}, nil, nil}, {640, 0, []byte{0x45, 0x41, 0x4d, 0x47}, nil, nil}}},
+	{961, 50, []*magicMatch{{0, 0, []byte{0x47, 0x3f, 0xff, 0x10}, nil, nil}, {0, 0, []byte{0x0, 0x0, 0x1, 0xb3}, nil, nil}, {0, 0, []byte{0x0, 0x0, 0x1, 0xba}, nil, nil}}},
+	{594, 50, []*magicMatch{{0, 0, []byte{0x46, 0x46, 0x49, 0x4c}, nil, nil}, {65, 0, []byte{0x46, 0x46, 0x49, 0x4c}, nil, nil}, {0, 0, []byte{0x0, 0x1, 0x0, 0x0, 0x0}, nil, nil}}},
+	{508, 50, []*magicMatch{{4, 0, []byte{0x49, 0x73, 0x0, 0x0}, nil, nil}, {4, 0, []byte{0x69, 0x62, 0x0, 0x0}, nil, nil}, {4, 0, []byte{0x42, 0x6b, 0x0, 0x0}, nil, nil}}},
+	{507, 50, []*magicMatch{{24, 0, []byte{0x5d, 0x1c, 0x9e, 0xa3}, nil, nil}, {0, 0, []byte{0x57, 0x42, 0x46, 0x53}, nil, nil}, {0, 0, []byte{0x57, 0x49, 0x49, 0x1, 0x44, 0x49, 0x53, 0x43}, nil, nil}}},
+	{387, 50, []*magicMatch{{0, 0, []byte{0x80, 0x37, 0x12, 0x40}, nil, nil}, {0, 0, []byte{0x37, 0x80, 0x40, 0x12}, nil, nil}, {0, 0, []byte{0x40, 0x12, 0x37, 0x80}, nil, nil}}},
+	{279, 50, []*magicMatch{{0, 0, []byte{0x13, 0x57, 0x9a, 0xce}, nil, nil}, {0, 0, []byte{0xce, 0x9a, 0x57, 0x13}, nil, nil}, {0, 0, []byte{0x47, 0x44, 0x42, 0x4d}, nil, nil}}},
+	{261, 50, []*magicMatch{{0, 0, []byte{0xff, 0x46, 0x4f, 0x4e}, nil, nil}, {7, 0, []byte{0x0, 0x45, 0x47, 0x41}, nil, nil}, {7, 0, []byte{0x0, 0x56, 0x49, 0x44}, nil, nil}}},
+	{651, 50, []*magicMatch{{8, 0, []byte{0x49, 0x4c, 0x42, 0x4d}, nil, nil}, {8, 0, []byte{0x50, 0x42, 0x4d, 0x20}, nil, nil}}},
+	{617, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x4d, 0x0, 0x2a}, nil, nil}, {0, 0, []byte{0x49, 0x49, 0x2a, 0x0}, nil, nil}}},
+	{591, 50, []*magicMatch{{0, 0, []byte{0x58, 0x4d, 0x46, 0x5f}, nil, nil}, {0, 0, []byte{0x58, 0x4d, 0x46, 0x5f, 0x32, 0x2e, 0x30, 0x30, 0x0, 0x0, 0x0, 0x2}, nil, nil}}},
+	{586, 50, []*magicMatch{{8, 0, []byte{0x57, 0x41, 0x56, 0x45}, nil, nil}, {8, 0, []byte{0x57, 0x41, 0x56, 0x20}, nil, nil}}},
+	{573, 50, []*magicMatch{{4, 0, []byte{0x57, 0x90, 0x75, 0x36}, nil, nil}, {8, 0, []byte{0x61, 0x61, 0x78, 0x20}, nil, nil}}},
+	{555, 50, []*magicMatch{{8, 0, []byte{0x41, 0x49, 0x46, 0x46}, nil, nil}, {8, 0, []byte{0x38, 0x53, 0x56, 0x58}, nil, nil}}},
+	{492, 50, []*magicMatch{{0, 0, []byte{0x23, 0x20, 0x44, 0x69, 0x73, 0x6b, 0x20, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6c, 0x65}, nil, nil}, {0, 0, []byte{0x4b, 0x44, 0x4d, 0x56}, nil, nil}}},
+	{452, 50, []*magicMatch{{0, 0, []byte{0x24, 0x46, 0x4c, 0x32}, nil, nil}, {0, 0, []byte{0x24, 0x46, 0x4c, 0x33}, nil, nil}}},
+	{398, 50, []*magicMatch{{0, 0, []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}, nil, nil}, {0, 0, []byte{0xd0, 0xcf, 0x11, 0xe0}, nil, nil}}},
+	{296, 50, []*magicMatch{{0, 0, []byte{0x89, 0x48, 0x44, 0x46, 0xd, 0xa, 0x1a, 0xa}, nil, nil}, {0, 0, []byte{0xe, 0x3, 0x13, 0x1}, nil, nil}}},
+	{283, 50, []*magicMatch{{0, 0, []byte{0xde, 0x12, 0x4, 0x95}, nil, nil}, {0, 0, []byte{0x95, 0x4, 0x12, 0xde}, nil, nil}}},
+	{248, 50, []*magicMatch{{0, 0, []byte{0x49, 0x57, 0x41, 0x44}, nil, nil}, {0, 0, []byte{0x50, 0x57, 0x41, 0x44}, nil, nil}}},
+	{242, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x75, 0x69, 0x20}, nil, nil}, {0, 256, []byte{0x3c, 0x55, 0x49, 0x20}, nil, nil}}},
+	{182, 50, []*magicMatch{{0, 0, []byte{0xa1, 0xb2, 0xc3, 0xd4}, nil, nil}, {0, 0, []byte{0xd4, 0xc3, 0xb2, 0xa1}, nil, nil}}},
+	{163, 50, []*magicMatch{{0, 0, []byte{0x73, 0x71, 0x73, 0x68}, nil, nil}, {0, 0, []byte{0x68, 0x73, 0x71, 0x73}, nil, nil}}},
+	{101, 50, []*magicMatch{{0, 0, []byte{0x30, 0x26, 0xb2, 0x75}, nil, nil}, {0, 0, []byte{0x5b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5d}, nil, nil}}},
+	{63, 50, []*magicMatch{{0, 0, []byte{0x76, 0x3d}, nil, []*magicMatch{{0, 256, []byte{0x73, 0x3d}, nil, nil}}}}},
+	{1002, 50, []*magicMatch{{0, 0, []byte{0x7a, 0x1a, 0x20, 0x10}, nil, nil}}},
+	{982, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x4f, 0x56, 0x49}, nil, nil}}},
+	{980, 50, []*magicMatch{{0, 0, []byte{0x4e, 0x53, 0x56, 0x66}, nil, nil}}},
+	{962, 50, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, nil}}},
+	{955, 50, []*magicMatch{{0, 0, []byte{0x1f, 0x7, 0x0, 0x0}, []byte{0xff, 0xff, 0xff, 0x0}, nil}}},
+	{693, 50, []*magicMatch{{0, 0, []byte{0x23, 0x46, 0x49, 0x47}, nil, nil}}},
+	{692, 50, []*magicMatch{{0, 0, []byte{0x58, 0x63, 0x75, 0x72}, nil, nil}}},
+	{684, 50, []*magicMatch{{0, 0, []byte{0x59, 0xa6, 0x6a, 0x95}, nil, nil}}},
+	{676, 50, []*magicMatch{{4, 0, []byte{0x69, 0x64, 0x61, 0x74}, nil, nil}}},
+	{661, 50, []*magicMatch{{0, 0, []byte{0x0, 0x4d, 0x52, 0x4d}, nil, nil}}},
+	{653, 50, []*magicMatch{{0, 0, []byte{0xff, 0x4f, 0xff, 0x51}, nil, nil}}},
+	{650, 50, []*magicMatch{{0, 0, []byte{0x69, 0x63, 0x6e, 0x73}, nil, nil}}},
+	{647, 50, []*magicMatch{{20, 0, []byte{0x47, 0x50, 0x41, 0x54}, nil, nil}}},
+	{645, 50, []*magicMatch{{20, 0, []byte{0x47, 0x49, 0x4d, 0x50}, nil, nil}}},
+	{643, 50, []*magicMatch{{0, 0, []byte{0x46, 0x50, 0x69, 0x78}, nil, nil}}},
+	{642, 50, []*magicMatch{{0, 0, []byte{0x76, 0x2f, 0x31, 0x1}, nil, nil}}},
+	{640, 50, []*magicMatch{{0, 0, []byte{0x28, 0x0, 0x0, 0x0}, nil, nil}}},
+	{624, 50, []*magicMatch{{0, 0, []byte{0x45, 0x50, 0x2a, 0x0}, nil, nil}}},
+	{613, 50, []*magicMatch{{0, 0, []byte{0x89, 0x50, 0x4e, 0x47}, nil, nil}}},
+	{604, 50, []*magicMatch{{0, 0, []byte{0x47, 0x49, 0x46, 0x38}, nil, nil}}},
+	{599, 50, []*magicMatch{{0, 0, []byte{0x53, 0x44, 0x50, 0x58}, nil, nil}}},
+	{596, 50, []*magicMatch{{0, 0, []byte{0x77, 0x4f, 0x46, 0x32}, nil, nil}}},
+	{595, 50, []*magicMatch{{0, 0, []byte{0x77, 0x4f, 0x46, 0x46}, nil, nil}}},
+	{593, 50, []*magicMatch{{0, 0, []byte{0x4f, 0x54, 0x54, 0x4f}, nil, nil}}},
+	{588, 50, []*magicMatch{{0, 0, []byte{0x77, 0x76, 0x70, 0x6b}, nil, nil}}},
+	{587, 50, []*magicMatch{{0, 0, []byte{0x77, 0x76, 0x70, 0x6b}, nil, nil}}},
+	{583, 50, []*magicMatch{{0, 0, []byte{0x54, 0x54, 0x41, 0x31}, nil, nil}}},
+	{578, 50, []*magicMatch{{44, 0, []byte{0x53, 0x43, 0x52, 0x4d}, nil, nil}}},
+	{561, 50, []*magicMatch{{0, 0, []byte{0x49, 0x4d, 0x50, 0x4d}, nil, nil}}},
+	{557, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x41, 0x43, 0x20}, nil, nil}}},
+	{554, 50, []*magicMatch{{8, 0, []byte{0x41, 0x49, 0x46, 0x43}, nil, nil}}},
+	{547, 50, []*magicMatch{{0, 0, []byte{0x50, 0x53, 0x49, 0x44}, nil, nil}}},
+	{546, 50, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, nil}}},
+	{542, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x54, 0x68, 0x64}, nil, nil}}},
+	{541, 50, []*magicMatch{{0, 0, []byte{0x66, 0x4c, 0x61, 0x43}, nil, nil}}},
+	{444, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x4d, 0x4d, 0x44}, nil, nil}}},
+	{441, 50, []*magicMatch{{0, 0, []byte{0x61, 0x6a, 0x6b, 0x67}, nil, nil}}},
+	{428, 50, []*magicMatch{{0, 0, []byte{0xed, 0xab, 0xee, 0xdb}, nil, nil}}},
+	{417, 50, []*magicMatch{{0, 0, []byte{0x99, 0x4e, 0xd, 0xa}, nil, nil}}},
+	{406, 50, []*magicMatch{{0, 0, []byte{0x4a, 0x6f, 0x79, 0x21}, nil, nil}}},
+	{399, 50, []*magicMatch{{31, 0, []byte{0x4f, 0x6c, 0x65, 0x6f}, nil, nil}}},
+	{371, 50, []*magicMatch{{102, 0, []byte{0x6d, 0x42, 0x49, 0x4e}, nil, nil}}},
+	{361, 50, []*magicMatch{{0, 0, []byte{0x23, 0x4c, 0x79, 0x58}, nil, nil}}},
+	{355, 50, []*magicMatch{{0, 0, []byte{0xd, 0x1a, 0x27, 0x1}, nil, nil}}},
+	{346, 50, []*magicMatch{{0, 0, []byte{0xd, 0x1a, 0x27, 0x2}, nil, nil}}},
+	{313, 50, []*magicMatch{{0, 0, []byte{0xfe, 0xed, 0xfe, 0xed}, nil, nil}}},
+	{311, 50, []*magicMatch{{0, 0, []byte{0xce, 0xce, 0xce, 0xce}, nil, nil}}},
+	{308, 50, []*magicMatch{{0, 0, []byte{0xca, 0xfe, 0xba, 0xbe}, nil, nil}}},
+	{276, 50, []*magicMatch{{28, 0, []byte{0xc2, 0x33, 0x9f, 0x3d}, nil, nil}}},
+	{273, 50, []*magicMatch{{0, 0, []byte{0x46, 0x4f, 0x4e, 0x54}, nil, nil}}},
+	{265, 50, []*magicMatch{{0, 0, []byte{0x1, 0x66, 0x63, 0x70}, nil, nil}}},
+	{263, 50, []*magicMatch{{0, 0, []byte{0x14, 0x2, 0x59, 0x19}, nil, nil}}},
+	{254, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x27, 0xa}, nil, nil}}},
+	{253, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x27, 0xa}, nil, nil}}},
+	{239, 50, []*magicMatch{{0, 0, []byte{0x0, 0x0, 0x0, 0x7b}, nil, nil}}},
+	{225, 50, []*magicMatch{{0, 0, []byte{0x43, 0x43, 0x4d, 0x58}, nil, nil}}},
+	{209, 50, []*magicMatch{{0, 0, []byte{0x4c, 0x59, 0x4e, 0x58}, nil, nil}}},
+	{195, 50, []*magicMatch{{0, 0, []byte{0x44, 0x4f, 0x53, 0x0}, nil, nil}}},
+	{190, 50, []*magicMatch{{0, 0, []byte{0x3f, 0x5f, 0x3, 0x0}, nil, nil}}},
+	{181, 50, []*magicMatch{{8, 0, []byte{0x19, 0x4, 0x0, 0x10}, nil, nil}}},
+	{160, 50, []*magicMatch{{0, 0, []byte{0x2e, 0x52, 0x4d, 0x46}, nil, nil}}},
+	{116, 50, []*magicMatch{{0, 0, []byte{0x78, 0x9f, 0x3e, 0x22}, nil, nil}}},
+	{88, 50, []*magicMatch{{36, 0, []byte{0x61, 0x63, 0x73, 0x70}, nil, nil}}},
+	{32, 50, []*magicMatch{{0, 0, []byte{0x4f, 0x67, 0x67, 0x53}, nil, nil}}},
+	{7, 50, []*magicMatch{{128, 0, []byte{0x44, 0x49, 0x43, 0x4d}, nil, nil}}},
+	{824, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x48, 0x54, 0x4d, 0x4c}, nil, nil}, {0, 256, []byte{0x3c, 0x21, 0x64, 0x6f, 0x63, 0x74, 0x79, 0x70, 0x65, 0x20, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}, {0, 256, []byte{0x3c, 0x48, 0x45, 0x41, 0x44}, nil, nil}, {0, 256, []byte{0x3c, 0x68, 0x65, 0x61, 0x64}, nil, nil}, {0, 256, []byte{0x3c, 0x54, 0x49, 0x54, 0x4c, 0x45}, nil, nil}, {0, 256, []byte{0x3c, 0x74, 0x69, 0x74, 0x6c, 0x65}, nil, nil}, {0, 256, []byte{0x3c, 0x48, 0x54, 0x4d, 0x4c}, nil, nil}, {0, 256, []byte{0x3c, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}, {0, 256, []byte{0x3c, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54}, nil, nil}, {0, 256, []byte{0x3c, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74}, nil, nil}, {0, 0, []byte{0x3c, 0x42, 0x4f, 0x44, 0x59}, nil, nil}, {0, 0, []byte{0x3c, 0x62, 0x6f, 0x64, 0x79}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x3c, 0x68, 0x31}, nil, nil}, {0, 0, []byte{0x3c, 0x48, 0x31}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x64, 0x6f, 0x63, 0x74, 0x79, 0x70, 0x65, 0x20, 0x48, 0x54, 0x4d, 0x4c}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x68, 0x74, 0x6d, 0x6c}, nil, nil}}},
+	{675, 50, []*magicMatch{{0, 0, []byte{0x50, 0x33}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}, {0, 0, []byte{0x50, 0x36}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}}},
+	{674, 50, []*magicMatch{{0, 0, []byte{0x50, 0x32}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}, {0, 0, []byte{0x50, 0x35}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}}},
+	{673, 50, []*magicMatch{{0, 0, []byte{0x50, 0x31}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}, {0, 0, []byte{0x50, 0x34}, nil, []*magicMatch{{2, 0, []byte{0xa}, nil, nil}, {2, 0, []byte{0x20}, nil, nil}, {2, 0, []byte{0x9}, nil, nil}, {2, 0, []byte{0xd}, nil, nil}}}}},
+	{686, 50, []*magicMatch{{1, 0, []byte{0x0, 0x2}, nil, []*magicMatch{{16, 0, []byte{0x8}, nil, nil}, {16, 0, []byte{0x10}, nil, nil}, {16, 0, []byte{0x18}, nil, nil}, {16, 0, []byte{0x20}, nil, nil}}}}},
+	{597, 50, []*magicMatch{{0, 0, []byte{0x42, 0x4d, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, []byte{0xff, 0xff, 0x0, 0x0, 0x0, 0x0, 0xff, 0xff}, nil}, {0, 0, []byte{0x42, 0x4d}, nil, []*magicMatch{{14, 0, []byte{0xc}, nil, nil}, {14, 0, []byte{0x40}, nil, nil}, {14, 0, []byte{0x28}, nil, nil}}}}},
+	{268, 50, []*magicMatch{{0, 0, []byte{0x53, 0x74, 0x61, 0x72, 0x74, 0x46, 0x6f, 0x6e, 0x74}, nil, nil}, {0, 0, []byte{0x13, 0x7a, 0x29}, nil, nil}, {8, 0, []byte{0x13, 0x7a, 0x2b}, nil, nil}}},
+	{68, 50, []*magicMatch{{0, 0, []byte{0x46, 0x57, 0x53}, nil, nil}, {0, 0, []byte{0x43, 0x57, 0x53}, nil, nil}}},
+	{971, 50, []*magicMatch{{0, 0, []byte{0x46, 0x4c, 0x56}, nil, nil}}},
+	{839, 50, []*magicMatch{{0, 256, []byte{0x3c, 0x54, 0x53}, nil, nil}}},
+	{832, 50, []*magicMatch{{0, 0, []byte{0x49, 0x44, 0x3b}, nil, nil}}},
+	{639, 50, []*magicMatch{{0, 0, []byte{0x44, 0x44, 0x53}, nil, nil}}},
+	{575, 50, []*magicMatch{{0, 0, []byte{0x50, 0x53, 0x46}, nil, nil}}},
+	{571, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x50, 0x2b}, nil, nil}}},
+	{566, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x4f, 0x33}, nil, nil}}},
+	{217, 50, []*magicMatch{{0, 0, []byte{0x42, 0x5a, 0x68}, nil, nil}}},
+	{194, 50, []*magicMatch{{0, 0, []byte{0x41, 0x4c, 0x5a}, nil, nil}}},
+	{188, 50, []*magicMatch{{1, 0, []byte{0x57, 0x50, 0x43}, nil, nil}}},
+	{37, 50, []*magicMatch{{0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x20, 0x4b, 0x45, 0x59, 0x20, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x20, 0x50, 0x47, 0x50, 0x20, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x20, 0x4b, 0x45, 0x59, 0x20, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d}, nil, nil}, {0, 0, []byte{0x95, 0x1}, nil, nil}, {0, 0, []byte{0x95, 0x0}, nil, nil}, {0, 0, []byte{0x99, 0x0}, nil, nil}, {0, 0, []byte{0x99, 0x1}, nil, nil}}},
+	{627, 50, []*magicMatch{{0, 0, []byte{0xa}, nil, []*magicMatch{{1, 0, []byte{0x0}, nil, nil}, {1, 0, []byte{0x2}, nil, nil}, {1, 0, []byte{0x3}, nil, nil}, {1, 0, []byte{0x5}, nil, nil}}}}},
+	{834, 50, []*magicMatch{{0, 0, []byte{0x2e, 0x5c, 0x22}, nil, nil}, {0, 0, []byte{0x27, 0x5c, 0x22}, nil, nil}, {0, 0, []byte{0x27, 0x2e, 0x5c, 0x22}, nil, nil}, {0, 0, []byte{0x5c, 0x22}, nil, nil}}},
+	{895, 50, []*magicMatch{{0, 0, []byte{0x7b, 0x31, 0x7d}, nil, nil}, {0, 0, []byte{0x7b, 0x30, 0x7d}, nil, nil}, {0, 6, []byte{0x7d, 0x7b}, nil, nil}}},
+	{269, 50, []*magicMatch{{0, 0, []byte{0xf7, 0x83}, nil, nil}, {0, 0, []byte{0xf7, 0x59}, nil, nil}, {0, 0, []byte{0xf7, 0xca}, nil, nil}}},
+	{970, 50, []*magicMatch{{0, 0, []byte{0x11, 0xaf}, nil, nil}, {0, 0, []byte{0x12, 0xaf}, nil, nil}}},
+	{866, 50, []*magicMatch{{0, 0, []byte{0xa, 0x28}, nil, nil}, {0, 0, []byte{0x3b, 0x45, 0x4c, 0x43, 0x13, 0x0, 0x0, 0x0}, nil, nil}}},
+	{608, 50, []*magicMatch{{0, 0, []byte{0xff, 0xd8, 0xff}, nil, nil}, {0, 0, []byte{0xff, 0xd8}, nil, nil}}},
+	{545, 50, []*magicMatch{{0, 0, []byte{0xff, 0xfb}, nil, nil}, {0, 0, []byte{0x49, 0x44, 0x33}, nil, nil}}},
+	{537, 50, []*magicMatch{{0, 0, []byte{0x41, 0x44, 0x49, 0x46}, nil, nil}, {0, 0, []byte{0xff, 0xf0}, []byte{0xff, 0xf6}, nil}}},
+	{270, 50, []*magicMatch{{2, 0, []byte{0x0, 0x11}, nil, nil}, {2, 0, []byte{0x0, 0x12}, nil, nil}}},
+	{54, 50, []*magicMatch{{0, 0, []byte{0x4, 0x25, 0x21}, nil, nil}, {0, 0, []byte{0x25, 0x21}, nil, nil}}},
+	{538, 50, []*magicMatch{{0, 0, []byte{0xb, 0x77}, nil, nil}}},
+	{380, 50, []*magicMatch{{0, 0, []byte{0x4d, 0x5a}, nil, nil}}},
+	{264, 50, []*magicMatch{{0, 0, []byte{0x36, 0x4}, nil, nil}}},
+	{250, 50, []*magicMatch{{0, 0, []byte{0xf7, 0x2}, nil, nil}}},
+	{232, 50, []*magicMatch{{0, 0, []byte{0x1f, 0x9d}, nil, nil}}},
+	{205, 50, []*magicMatch{{0, 0, []byte{0x60, 0xea}, nil, nil}}},
+	{14, 50, []*magicMatch{{0, 0, []byte{0x1f, 0x8b}, nil, nil}}},
+	{204, 45, []*magicMatch{{0, 0, []byte{0x3c, 0x61, 0x72, 0x3e}, nil, nil}, {0, 0, []byte{0x21, 0x3c, 0x61, 0x72, 0x63, 0x68, 0x3e}, nil, nil}}},
+	{427, 45, []*magicMatch{{0, 0, []byte{0x52, 0x49, 0x46, 0x46}, nil, nil}}},
+	{409, 40, []*magicMatch{{0, 256, []byte{0xa, 0x3d, 0x70, 0x6f, 0x64}, nil, nil}, {0, 256, []byte{0xa, 0x3d, 0x68, 0x65, 0x61, 0x64, 0x31, 0x20, 0x4e, 0x41, 0x4d, 0x45}, nil, nil}, {0, 256, []byte{0xa, 0x3d, 0x68, 0x65, 0x61, 0x64, 0x31, 0x20, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e}, nil, nil}}},
+	{527, 40, []*magicMatch{{0, 0, []byte{0x3c, 0x3f, 0x78, 0x6d, 0x6c}, nil, nil}, {0, 0, []byte{0x3c, 0x21, 0x2d, 0x2d}, nil, nil}}},
+	{540, 40, []*magicMatch{{0, 0, []byte{0x2e, 0x73, 0x6e, 0x64}, nil, nil}}},
+	{302, 40, []*magicMatch{{0, 0, []byte{0x46, 0x4f, 0x52, 0x4d}, nil, nil}}},
+	{567, 40, []*magicMatch{{0, 0, []byte{0x4d, 0x54, 0x4d}, nil, nil}, {0, 0, []byte{0x4d, 0x4d, 0x44, 0x30}, nil, nil}, {0, 0, []byte{0x4d, 0x4d, 0x44, 0x31}, nil, nil}, {0, 0, []byte{0x69, 0x66}, nil, []*magicMatch{{110, 0, []byte{0x0}, []byte{0xc0}, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}, {110, 0, []byte{0x40}, nil, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}}}, {0, 0, []byte{0x4a, 0x4e}, nil, []*magicMatch{{110, 0, []byte{0x0}, []byte{0xc0}, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}, {110, 0, []byte{0x40}, nil, []*magicMatch{{111, 0, []byte{0x0}, []byte{0x80}, nil}, {111, 0, []byte{0x80}, nil, nil}}}}}, {0, 0, []byte{0x4d, 0x41, 0x53, 0x5f, 0x55, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x56, 0x30, 0x30}, nil, nil}}},
+	{972, 40, []*magicMatch{{0, 0, []byte{0x46, 0x4c, 0x56}, nil, nil}}},
+	{255, 40, []*magicMatch{{0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x1}, nil, []*magicMatch{{16, 0, []byte{0x2, 0x0}, nil, nil}}}}}, {0, 0, []byte{0x7f, 0x45, 0x4c, 0x46}, nil, []*magicMatch{{5, 0, []byte{0x2}, nil, []*magicMatch{{16, 0, []byte{0x0, 0x2}, nil, nil}}}}}, {0, 0, []byte{0x4d, 0x5a}, nil, nil}, {0, 0, []byte{0x1c, 0x52}, nil, nil}, {0, 0, []byte{0x1, 0x10}, nil, nil}, {0, 0, []byte{0x1, 0x11}, nil, nil}, {0, 0, []byte{0x83, 0x1}, nil, nil}}},
+	{378, 30, []*magicMatch{{60, 0, []byte{0x54, 0x45, 0x58, 0x74, 0x52, 0x45, 0x41, 0x64}, nil, nil}}},
+	{905, 30, []*magicMatch{{0, 0, []byte{0x23, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74}, nil, nil}}},
+	{860, 30, []*magicMatch{{0, 0, []byte{0x2f, 0x2a}, nil, nil}, {0, 0, []byte{0x2f, 0x2f}, nil, nil}, {0, 0, []byte{0x23, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65}, nil, nil}}},
+	{24, 20, []*magicMatch{{0, 0, []byte{0x46, 0x72, 0x6f, 0x6d, 0x20}, nil, nil}}},
+	{686, 10, []*magicMatch{{1, 0, []byte{0x1, 0x1}, nil, nil}, {1, 0, []byte{0x1, 0x9}, nil, nil}, {1, 0, []byte{0x0, 0x3}, nil, nil}, {1, 0, []byte{0x0, 0xa}, nil, nil}, {1, 0, []byte{0x0, 0xb}, nil, nil}}},
+	{897, 10, []*magicMatch{{0, 0, []byte{0x2f, 0x2f}, nil, nil}}},
+	{892, 10, []*magicMatch{{0, 0, []byte{0x23, 0x23}, nil, nil}}},
+	{933, 10, []*magicMatch{{0, 0, []byte{0x25}, nil, nil}}},
+	{892, 10, []*magicMatch{{0, 0, []byte{0x25}, nil, nil}}},
 }
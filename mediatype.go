@@ -4,6 +4,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"syscall"
 )
 
@@ -42,14 +44,40 @@ func (m MediaType) IsExtension(ext string) bool {
 	return false
 }
 
+// Is reports whether m is the MIME type name, one of its aliases
+// or a subclass of either. A name in the form of "image/*"
+// matches every MIME type of that media, as well as their
+// subclasses, so application/xhtml+xml is "text/*".
+func (m MediaType) Is(name string) bool {
+	if strings.HasSuffix(name, "/*") {
+		media := name[:len(name)-2]
+		return m.Media == media || isA(m.subClassOf, func(i int) bool { return mediaTypes[i].Media == media })
+	}
+	i := lookup(name)
+	if i < 0 {
+		return m.MediaType() == name
+	}
+	return m.MediaType() == mediaTypes[i].MediaType() || isA(m.subClassOf, func(j int) bool { return j == i })
+}
+
+func isA(subClassOf []int, f func(int) bool) bool {
+	for _, i := range subClassOf {
+		if f(i) || isA(mediaTypes[i].subClassOf, f) {
+			return true
+		}
+	}
+	return false
+}
+
 // MatchFilePath is a file path convenience wrapper for MatchReader.
+// Symbolic links are followed, and special files, such as FIFOs,
+// are identified by their type without being opened, as with
+// MatchPath, except that every directory, mount points included,
+// is inode/directory.
 func MatchFilePath(path string, limAndPref ...int) (m MediaType, err error) {
-	f, err := os.Open(path)
-	if err != nil {
-		return mediaTypes[unknownType], err
-	}
-	defer f.Close()
-	return MatchFile(f, limAndPref...)
+	limit, preference := limitAndPreference(limAndPref)
+	i, _, err := matchPath(path, true, false, limit, preference)
+	return mediaTypes[i], err
 }
 
 // MatchFile is an *os.File convenience wrapper for MatchReader.
@@ -64,6 +92,12 @@ func MatchFile(f *os.File, limAndPref ...int) (MediaType, error) {
 // Negative or non-existent values of limit will read the
 // file up until the longest magic signature in the database.
 func MatchReader(r io.Reader, filename string, limAndPref ...int) (MediaType, error) {
+	limit, preference := limitAndPreference(limAndPref)
+	m, err := matchReader(r, filename, limit, preference)
+	return mediaTypes[m], err
+}
+
+func limitAndPreference(limAndPref []int) (int, int) {
 	limit := magicMaxLen
 	preference := Default
 	if len(limAndPref) > 0 && limAndPref[0] >= 0 && limAndPref[0] < magicMaxLen {
@@ -72,19 +106,46 @@ func MatchReader(r io.Reader, filename string, limAndPref ...int) (MediaType, er
 	if len(limAndPref) > 1 && limAndPref[1] <= Glob {
 		preference = limAndPref[1]
 	}
-	data := make([]byte, limit)
-	//io.EOF check for zero-size files
-	if n, err := io.ReadAtLeast(r, data, limit); err == io.ErrUnexpectedEOF || err == io.EOF {
-		data = data[:n]
-	} else if pErr, ok := err.(*os.PathError); ok && pErr.Err == syscall.EISDIR {
-		return mediaTypes[unknownDirectory], nil
+	return limit, preference
+}
+
+// bufferPool holds the buffers the head of a file is read into,
+// which don't outlive the matching. Buffers pooled before Register
+// raised magicMaxLen are grown on use.
+var bufferPool = sync.Pool{New: func() interface{} {
+	b := make([]byte, magicMaxLen)
+	return &b
+}}
+
+func matchReader(r io.Reader, filename string, limit, preference int) (int, error) {
+	buf := bufferPool.Get().(*[]byte)
+	defer bufferPool.Put(buf)
+	if cap(*buf) < limit {
+		*buf = make([]byte, magicMaxLen)
+	}
+	data, err := readInto(r, (*buf)[:limit])
+	if pErr, ok := err.(*os.PathError); ok && pErr.Err == syscall.EISDIR {
+		return unknownDirectory, nil
 	} else if err != nil {
-		return mediaTypes[unknownType], err
+		return unknownType, err
 	}
 	if filename == "" {
-		return MatchMagic(data), nil
+		return matchMagic(data), nil
+	}
+	return match(data, filename, preference), nil
+}
+
+func readData(r io.Reader, limit int) ([]byte, error) {
+	return readInto(r, make([]byte, limit))
+}
+
+func readInto(r io.Reader, data []byte) ([]byte, error) {
+	//io.EOF check for zero-size files
+	n, err := io.ReadAtLeast(r, data, len(data))
+	if err == io.ErrUnexpectedEOF || err == io.EOF {
+		return data[:n], nil
 	}
-	return Match(data, filename, preference), nil
+	return data[:n], err
 }
 
 // Match determines the MIME type of the file in a byte slice
@@ -135,6 +196,31 @@ func match(data []byte, filename string, preference int) int {
 	return match
 }
 
+var (
+	nameIndex     map[string]int
+	nameIndexOnce sync.Once
+)
+
+// lookup returns the index of the MIME type or alias name in
+// mediaTypes, or -1 if it isn't in the database.
+func lookup(name string) int {
+	nameIndexOnce.Do(func() {
+		nameIndex = make(map[string]int, len(mediaTypes))
+		for i := range mediaTypes {
+			for _, a := range mediaTypes[i].Alias {
+				nameIndex[a] = i
+			}
+		}
+		for i := range mediaTypes {
+			nameIndex[mediaTypes[i].MediaType()] = i
+		}
+	})
+	if i, ok := nameIndex[name]; ok {
+		return i
+	}
+	return -1
+}
+
 func equalOrSuperClass(globMatches []int, magicMatch int) int {
 	for i := range globMatches {
 		if magicMatch == globMatches[i] || equalOrSuperClass(mediaTypes[globMatches[i]].subClassOf, magicMatch) > -1 {
@@ -688,3 +688,30 @@ func TestMediaType_IsExtension(t *testing.T) {
 		})
 	}
 }
+
+func TestMediaType_Is(t *testing.T) {
+	tests := []struct {
+		mediaType, name string
+		want            bool
+	}{
+		{"image/png", "image/png", true},
+		{"image/png", "image/*", true},
+		{"image/png", "image/jpeg", false},
+		{"application/epub+zip", "application/zip", true},
+		{"application/epub+zip", "application/x-zip-compressed", true},
+		{"application/zip", "application/epub+zip", false},
+		{"application/xhtml+xml", "text/*", true},
+		{"text/x-csrc", "text/plain", true},
+		{"image/svg+xml", "application/xml", true},
+		{"image/svg+xml", "text/xml", true},
+		{"image/svg+xml", "audio/*", false},
+		{"image/png", "not/a-type", false},
+	}
+	for _, test := range tests {
+		t.Run(test.mediaType+" "+test.name, func(t *testing.T) {
+			if got := mediaTypes[lookup(test.mediaType)].Is(test.name); got != test.want {
+				t.Errorf("MediaType.Is() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"bufio"
+	"encoding/xml"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+// TestDecompile checks that the magic signatures decompiled from the
+// tables parse back into the signatures the tables were generated
+// from.
+func TestDecompile(t *testing.T) {
+	f, err := os.Open("../magicsigs.go")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer f.Close()
+	want := make(map[int][]string)
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		line := s.Text()
+		if !strings.HasPrefix(line, "\t{") {
+			continue
+		}
+		line = strings.TrimSuffix(line[1:], ",")
+		i, err := strconv.Atoi(line[1:strings.IndexByte(line, ',')])
+		if err != nil {
+			t.Fatalf("Atoi() error = %v", err)
+		}
+		want[i] = append(want[i], line)
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	type mimeTypeElement struct {
+		Type  string                     `xml:"type,attr"`
+		Magic []mimemagic.MagicSignature `xml:"magic"`
+	}
+	doc := struct {
+		XMLName  xml.Name          `xml:"http://www.freedesktop.org/standards/shared-mime-info mime-info"`
+		MIMEType []mimeTypeElement `xml:"mime-type"`
+	}{}
+	index := make(map[string]int)
+	for i, m := range mimemagic.MediaTypes() {
+		index[m.MediaType()] = i
+		if signatures := m.Magic(); len(signatures) > 0 {
+			doc.MIMEType = append(doc.MIMEType, mimeTypeElement{m.MediaType(), signatures})
+		}
+	}
+	data, err := xml.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var m MIMEInfo
+	if err := xml.Unmarshal(data, &m); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	info, err := parseMIMEInfo(&m)
+	if err != nil {
+		t.Fatalf("parseMIMEInfo() error = %v", err)
+	}
+	got := make(map[int][]string)
+	for _, p := range info {
+		i := index[p.Media+"/"+p.Subtype]
+		for _, magic := range p.Magic {
+			magic.MIMEType = i
+			got[i] = append(got[i], magic.String())
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("decompiled the magic of %d MIME types, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if g := got[i]; !reflect.DeepEqual(g, w) {
+			t.Errorf("MIME type %d: decompiled magic = %v, want %v", i, g, w)
+		}
+	}
+}
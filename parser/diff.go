@@ -0,0 +1,199 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+// Diff writes the differences between the MIME types of databases
+// a and b to w, and reports whether there are any: added and
+// removed MIME types, and changed aliases, parents, glob patterns
+// and magic.
+func Diff(w io.Writer, a, b *Database) bool {
+	return diffTypes(w, a.types, b.types)
+}
+
+func diffTypes(w io.Writer, oldTypes, newTypes map[string]*parsedMIMEType) bool {
+	names := make([]string, 0, len(oldTypes)+len(newTypes))
+	for t := range oldTypes {
+		names = append(names, t)
+	}
+	for t := range newTypes {
+		if _, ok := oldTypes[t]; !ok {
+			names = append(names, t)
+		}
+	}
+	sort.Strings(names)
+	changed := false
+	for _, t := range names {
+		o, n := oldTypes[t], newTypes[t]
+		switch {
+		case o == nil:
+			fmt.Fprintf(w, "+ %s\n", t)
+		case n == nil:
+			fmt.Fprintf(w, "- %s\n", t)
+		default:
+			var lines []string
+			lines = append(lines, diffStrings("alias", o.Alias, n.Alias)...)
+			lines = append(lines, diffStrings("parent", o.SubClassOf, n.SubClassOf)...)
+			lines = append(lines, diffGlobs(o.Glob, n.Glob)...)
+			lines = append(lines, diffStrings("magic", magicStrings(o.Magic), magicStrings(n.Magic))...)
+			if len(lines) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "~ %s\n", t)
+			for _, l := range lines {
+				fmt.Fprintf(w, "    %s\n", l)
+			}
+		}
+		changed = true
+	}
+	return changed
+}
+
+// diffStrings returns the strings only in a, prefixed by "-", and
+// those only in b, prefixed by "+", counting duplicates.
+func diffStrings(kind string, a, b []string) []string {
+	count := make(map[string]int)
+	for _, s := range a {
+		count[s]--
+	}
+	for _, s := range b {
+		count[s]++
+	}
+	var removed, added []string
+	for _, s := range a {
+		if count[s] < 0 {
+			removed = append(removed, fmt.Sprintf("- %s %s", kind, s))
+			count[s]++
+		}
+	}
+	for _, s := range b {
+		if count[s] > 0 {
+			added = append(added, fmt.Sprintf("+ %s %s", kind, s))
+			count[s]--
+		}
+	}
+	return append(removed, added...)
+}
+
+func diffGlobs(a, b []*parsedGlob) []string {
+	key := func(g *parsedGlob) string {
+		if g.CaseSensitive {
+			return g.Pattern + " (case-sensitive)"
+		}
+		return g.Pattern
+	}
+	weights := make(map[string]int)
+	for _, g := range a {
+		weights[key(g)] = g.Weight
+	}
+	var lines []string
+	seen := make(map[string]bool)
+	for _, g := range b {
+		k := key(g)
+		seen[k] = true
+		switch w, ok := weights[k]; {
+		case !ok:
+			lines = append(lines, fmt.Sprintf("+ glob %s (weight %d)", k, g.Weight))
+		case w != g.Weight:
+			lines = append(lines, fmt.Sprintf("~ glob %s (weight %d -> %d)", k, w, g.Weight))
+		}
+	}
+	for _, g := range a {
+		if k := key(g); !seen[k] {
+			lines = append(lines, fmt.Sprintf("- glob %s (weight %d)", k, g.Weight))
+		}
+	}
+	return lines
+}
+
+// magicStrings describes each signature on one line, with the
+// rules nested in braces.
+func magicStrings(magic []*parsedMagic) []string {
+	s := make([]string, len(magic))
+	for i, m := range magic {
+		s[i] = fmt.Sprintf("(priority %d) %s", m.Priority, matchesString(m.Match))
+	}
+	return s
+}
+
+func matchesString(matches []*parsedMatch) string {
+	s := make([]string, len(matches))
+	for i, m := range matches {
+		offset := fmt.Sprint(m.RangeStart)
+		if m.RangeLength > 0 {
+			offset = fmt.Sprintf("%d:%d", m.RangeStart, m.RangeStart+m.RangeLength)
+		}
+		s[i] = fmt.Sprintf("[%s] %q", offset, m.Data)
+		if m.Mask != nil {
+			s[i] += fmt.Sprintf(" & %#x", m.Mask)
+		}
+		if len(m.Match) > 0 {
+			s[i] += " {" + matchesString(m.Match) + "}"
+		}
+	}
+	return strings.Join(s, ", ")
+}
+
+// DiffCorpus writes the files under root whose MIME type detected
+// by database a differs from the one detected by database b to w,
+// followed by a count, and reports whether there are any. The files
+// are matched by the engine of package mimemagic, registering the
+// tables of each database in turn, which leaves those of b
+// registered.
+func DiffCorpus(w io.Writer, root string, a, b *Database) (bool, error) {
+	before, err := detectCorpus(root, a)
+	if err != nil {
+		return false, err
+	}
+	after, err := detectCorpus(root, b)
+	if err != nil {
+		return false, err
+	}
+	paths := make([]string, 0, len(before))
+	for path := range before {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	changed := 0
+	for _, path := range paths {
+		if b, a := before[path], after[path]; b != a {
+			fmt.Fprintf(w, "%s: %s -> %s\n", path, b, a)
+			changed++
+		}
+	}
+	fmt.Fprintf(w, "%d of %d files changed MIME type\n", changed, len(before))
+	return changed > 0, nil
+}
+
+// detectCorpus registers the tables of db, and returns the MIME
+// types of the regular files under root it detects.
+func detectCorpus(root string, db *Database) (map[string]string, error) {
+	t, err := db.Tables()
+	if err != nil {
+		return nil, err
+	}
+	if err = mimemagic.Register(t); err != nil {
+		return nil, err
+	}
+	detected := make(map[string]string)
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		m, err := mimemagic.MatchFilePath(path)
+		if err != nil {
+			return err
+		}
+		detected[path] = m.MediaType()
+		return nil
+	})
+	return detected, err
+}
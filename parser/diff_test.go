@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffTypes(t *testing.T) {
+	png := func(weight int, alias ...string) *parsedMIMEType {
+		return &parsedMIMEType{
+			Media:   "image",
+			Subtype: "png",
+			Alias:   alias,
+			Glob:    []*parsedGlob{{"*.png", weight, false}},
+			Magic:   []*parsedMagic{{Priority: 50, Match: []*parsedMatch{{Data: []byte("\x89PNG")}}}},
+		}
+	}
+	oldTypes := map[string]*parsedMIMEType{
+		"image/png":  png(50, "image/x-png"),
+		"image/gif":  {Media: "image", Subtype: "gif"},
+		"text/plain": {Media: "text", Subtype: "plain"},
+	}
+	newTypes := map[string]*parsedMIMEType{
+		"image/png":  png(60),
+		"image/webp": {Media: "image", Subtype: "webp"},
+		"text/plain": {Media: "text", Subtype: "plain"},
+	}
+	newTypes["image/png"].Magic[0].Priority = 80
+	var b strings.Builder
+	if !diffTypes(&b, oldTypes, newTypes) {
+		t.Error("diffTypes() = false, want true")
+	}
+	want := "- image/gif\n" +
+		"~ image/png\n" +
+		"    - alias image/x-png\n" +
+		"    ~ glob *.png (weight 50 -> 60)\n" +
+		"    - magic (priority 50) [0] \"\\x89PNG\"\n" +
+		"    + magic (priority 80) [0] \"\\x89PNG\"\n" +
+		"+ image/webp\n"
+	if got := b.String(); got != want {
+		t.Errorf("diffTypes() wrote\n%s\nwant\n%s", got, want)
+	}
+	if diffTypes(&b, oldTypes, oldTypes) {
+		t.Error("diffTypes() of the same types = true, want false")
+	}
+}
+
+func TestDiffCorpus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "corpus")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	load := func(name, mimeType string) *Database {
+		data := `<?xml version="1.0" encoding="UTF-8"?>
+<mime-info xmlns="http://www.freedesktop.org/standards/shared-mime-info">
+  <mime-type type="` + mimeType + `">
+    <glob pattern="*.a"/>
+  </mime-type>
+  <mime-type type="image/x-b">
+    <magic><match type="string" value="b" offset="0"/></magic>
+  </mime-type>
+</mime-info>
+`
+		d := filepath.Join(dir, name)
+		if err := os.Mkdir(d, 0755); err != nil {
+			t.Fatalf("Mkdir() error = %v", err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(d, "freedesktop.org.xml"), []byte(data), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		db, err := Load(d)
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		return db
+	}
+	a, b := load("a", "text/x-a"), load("b", "text/x-c")
+	corpus := filepath.Join(dir, "corpus")
+	if err = os.Mkdir(corpus, 0755); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+	for name, data := range map[string]string{"x.a": "a", "x.b": "b"} {
+		if err = ioutil.WriteFile(filepath.Join(corpus, name), []byte(data), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	defer registerShipped(t)
+	var w strings.Builder
+	changed, err := DiffCorpus(&w, corpus, a, b)
+	if err != nil || !changed {
+		t.Fatalf("DiffCorpus() = %v, %v, want true", changed, err)
+	}
+	want := filepath.Join(corpus, "x.a") + ": text/x-a -> text/x-c\n" +
+		"1 of 2 files changed MIME type\n"
+	if got := w.String(); got != want {
+		t.Errorf("DiffCorpus() wrote\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,387 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"sort"
+	"text/template"
+)
+
+// Table is a set of the tables of package mimemagic.
+type Table uint
+
+// The tables of package mimemagic, each generated into its own
+// file by the parser command.
+const (
+	MediaTypesTable Table = 1 << iota // mediatypes.go
+	GlobsTable                        // globs.go
+	MagicTable                        // magicsigs.go
+	TreeMagicTable                    // treemagicsigs.go
+	NamespacesTable                   // namespaces.go
+
+	AllTables = MediaTypesTable | GlobsTable | MagicTable | TreeMagicTable | NamespacesTable
+)
+
+// Options configures Generate.
+type Options struct {
+	// Tables are the tables to generate, or all of them if zero.
+	Tables Table
+	// Package is the name of the package the tables are generated
+	// into, mimemagic if empty. The tables of any other package
+	// implement mimemagic.Tables, exported as the Tables variable
+	// along with the media types table, for mimemagic.Register.
+	Package string
+}
+
+var (
+	headerTemplate = template.Must(template.New("").Parse(`// Code generated by cmd/parser from shared-mime-info packages sha256:{{ .Hash }}. DO NOT EDIT.
+
+package {{ .Package }}
+{{- if .Qualified }}
+
+import "github.com/zRedShift/mimemagic/v2"
+{{- end }}
+`))
+	typesTemplate = template.Must(template.New("").Parse(`
+var (
+	unknownType      = {{ .OctetStream }}
+	emptyDocument    = {{ .ZeroSize }}
+	plainText        = {{ .PlainText }}
+	unknownDirectory = {{ .Dir }}
+	unknownXML       = {{ .XML }}
+)
+
+var mediaTypes = []MediaType{
+{{- range .TypeSlice }}
+	{{ printf "%s" (index $.Types .)  }},
+{{- end }}
+}
+`))
+	identifiersTemplate = template.Must(template.New("").Parse(`
+var globMaxLen = {{ .MaxLen }}
+
+var globs = []glob{
+{{- range .Patterns }}
+	{{ printf "%s" . }},
+{{- end }}
+}
+
+var suffixes = map[string][]simpleGlob{
+{{- range $k, $v := .Suffix }}
+	{{ printf "%q: %#v" $k $v }},
+{{- end }}
+}
+
+var suffixesCS = map[string][]simpleGlob{
+{{- range $k, $v := .CaseSensitiveSuffix }}
+	{{ printf "%q: %#v" $k $v }},
+{{- end }}
+}
+
+var prefixes = map[string][]simpleGlob{
+{{- range $k, $v := .Prefix }}
+	{{ printf "%q: %#v" $k $v }},
+{{- end }}
+}
+
+var prefixesCS = map[string][]simpleGlob{
+{{- range $k, $v := .CaseSensitivePrefix }}
+	{{ printf "%q: %#v" $k $v }},
+{{- end }}
+}
+
+var text = map[string][]simpleGlob{
+{{- range $k, $v := .Text }}
+	{{ printf "%q: %#v" $k $v }},
+{{- end }}
+}
+
+var textCS = map[string][]simpleGlob{
+{{- range $k, $v := .CaseSensitiveText }}
+	{{ printf "%q: %#v" $k $v }},
+{{- end }}
+}
+`))
+	magicTemplate = template.Must(template.New("").Parse(`
+var magicMaxLen = {{ .MaxLen }}
+
+var magicSignatures = []magic{
+{{- range .Magic }}
+	{{ printf "%s" . }},
+{{- end }}
+}
+`))
+	treeMagicTemplate = template.Must(template.New("").Parse(`
+var treeMagicSignatures = []treeMagic{
+{{- range .TreeMagic }}
+	{{ printf "%s" . }},
+{{- end }}
+}
+`))
+	rootXMLTemplate = template.Must(template.New("").Parse(`
+var namespaces = []namespace{
+{{- range .RootXML }}
+	{{ printf "%s" . }},
+{{- end }}
+}
+`))
+	qualifiedTypesTemplate = template.Must(template.New("").Parse(`
+// Tables is the MIME type database of the package, for
+// mimemagic.Register.
+var Tables mimemagic.Tables = tables{}
+
+type tables struct{}
+
+func (tables) MediaTypes() []mimemagic.MediaType { return mediaTypes }
+
+func (tables) Globs() []mimemagic.TableGlob { return globs }
+
+func (tables) Magic() []mimemagic.TableMagic { return magicSignatures }
+
+func (tables) XMLRoots() []mimemagic.TableXMLRoot { return namespaces }
+
+func (tables) TreeMagic() []mimemagic.TableTreeMagic { return treeMagicSignatures }
+
+var mediaTypes = []mimemagic.MediaType{
+{{- range .TypeSlice }}
+	{{ (index $.Types .).Qualified }},
+{{- end }}
+}
+`))
+	qualifiedGlobsTemplate = template.Must(template.New("").Parse(`
+var globs = []mimemagic.TableGlob{
+{{- range .TypeGlobs }}
+	{{ .Qualified }},
+{{- end }}
+}
+`))
+	qualifiedMagicTemplate = template.Must(template.New("").Parse(`
+var magicSignatures = []mimemagic.TableMagic{
+{{- range .Magic }}
+	{{ .Qualified }},
+{{- end }}
+}
+`))
+	qualifiedTreeMagicTemplate = template.Must(template.New("").Parse(`
+var treeMagicSignatures = []mimemagic.TableTreeMagic{
+{{- range .TreeMagic }}
+	{{ .Qualified }},
+{{- end }}
+}
+`))
+	qualifiedRootXMLTemplate = template.Must(template.New("").Parse(`
+var namespaces = []mimemagic.TableXMLRoot{
+{{- range .RootXML }}
+	{{ .Qualified }},
+{{- end }}
+}
+`))
+)
+
+// tables are the tables of package mimemagic, indexed and sorted
+// the way the matching engine expects.
+type tables struct {
+	Types                 map[string]*parsedMIMEType
+	TypeSlice             []string
+	ZeroSize, OctetStream int
+	PlainText, Dir, XML   int
+	Globs                 *globTables
+	TypeGlobs             []typeGlob
+	Magic                 magicSliceType
+	MagicMaxLen           int
+	TreeMagic             treeMagicSliceType
+	RootXML               rootXMLSliceType
+}
+
+// Generate writes the gofmt-ed Go source of the tables for the
+// database to w, headed by the checksum of the package files. The
+// output depends on nothing but the package files and opts, so
+// generating it again shows whether the tables are up to date.
+func (db *Database) Generate(w io.Writer, opts Options) error {
+	if opts.Package == "" {
+		opts.Package = "mimemagic"
+	}
+	if !token.IsIdentifier(opts.Package) {
+		return fmt.Errorf("invalid package name %s", opts.Package)
+	}
+	t, err := db.tables()
+	if err != nil {
+		return err
+	}
+	if opts.Tables == 0 {
+		opts.Tables = AllTables
+	}
+	qualified := opts.Package != "mimemagic"
+	var b bytes.Buffer
+	if err = headerTemplate.Execute(&b, struct {
+		Package, Hash string
+		Qualified     bool
+	}{opts.Package, db.hashSum(), qualified}); err != nil {
+		return err
+	}
+	type generator struct {
+		table    Table
+		template *template.Template
+		data     interface{}
+	}
+	generators := []generator{
+		{MediaTypesTable, typesTemplate, t},
+		{GlobsTable, identifiersTemplate, t.Globs},
+		{MagicTable, magicTemplate, struct {
+			Magic  magicSliceType
+			MaxLen int
+		}{t.Magic, t.MagicMaxLen}},
+		{TreeMagicTable, treeMagicTemplate, t},
+		{NamespacesTable, rootXMLTemplate, t},
+	}
+	if qualified {
+		generators = []generator{
+			{MediaTypesTable, qualifiedTypesTemplate, t},
+			{GlobsTable, qualifiedGlobsTemplate, t},
+			{MagicTable, qualifiedMagicTemplate, t},
+			{TreeMagicTable, qualifiedTreeMagicTemplate, t},
+			{NamespacesTable, qualifiedRootXMLTemplate, t},
+		}
+	}
+	for _, g := range generators {
+		if opts.Tables&g.table == 0 {
+			continue
+		}
+		if err = g.template.Execute(&b, g.data); err != nil {
+			return err
+		}
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// tables builds the tables from copies of the MIME types, so that
+// the database can be generated from, linted or diffed again.
+func (db *Database) tables() (*tables, error) {
+	t := &tables{Types: db.withDefaults()}
+	for name, p := range t.Types {
+		t.Types[name] = p.clone()
+	}
+	t.TypeSlice = sortedTypes(t.Types)
+	aliases := make(map[string]string)
+	var identifiers identifierSliceType
+	for i, name := range t.TypeSlice {
+		t.Types[name].Lexicographic = i
+		for _, a := range t.Types[name].Alias {
+			aliases[a] = name
+		}
+		switch name {
+		case "text/plain":
+			t.PlainText = i
+		case "application/x-zerosize":
+			t.ZeroSize = i
+		case "application/octet-stream":
+			t.OctetStream = i
+		case "inode/directory":
+			t.Dir = i
+		case "application/xml":
+			t.XML = i
+		}
+	}
+	for i, name := range t.TypeSlice {
+		p := t.Types[name]
+		for _, sb := range p.SubClassOf {
+			if _, ok := t.Types[sb]; !ok {
+				if sb, ok = aliases[sb]; !ok {
+					continue
+				}
+			}
+			if n := t.Types[sb].Lexicographic; !containsInt(p.subClassOf, n) {
+				p.subClassOf = append(p.subClassOf, n)
+			}
+		}
+		for _, g := range p.Glob {
+			id, err := globMatcher(g, i)
+			if err != nil {
+				return nil, fmt.Errorf("%s, %v", g.Pattern, err)
+			}
+			identifiers = append(identifiers, id)
+			t.TypeGlobs = append(t.TypeGlobs, typeGlob{g, i})
+		}
+		signatures := make(map[string]*parsedMagic)
+		for _, m := range p.Magic {
+			m.MIMEType = i
+			if d, ok := signatures[m.matchersString()]; ok {
+				if m.Priority > d.Priority {
+					d.Priority = m.Priority
+				}
+				continue
+			}
+			signatures[m.matchersString()] = m
+			t.Magic = append(t.Magic, m)
+			if l := m.MaxLen(); l > t.MagicMaxLen {
+				t.MagicMaxLen = l
+			}
+		}
+		for _, x := range p.RootXML {
+			x.MIMEType = i
+			t.RootXML = append(t.RootXML, x)
+		}
+		for _, m := range p.TreeMagic {
+			m.MIMEType = i
+			setMediaTypes(m.TreeMatch, t.Types)
+			t.TreeMagic = append(t.TreeMagic, m)
+		}
+	}
+	sort.Stable(identifiers)
+	t.Globs = identifiers.GenerateMaps()
+	for _, m := range t.Magic {
+		m.ancestors = t.ancestors(m.MIMEType)
+	}
+	for _, m := range t.TreeMagic {
+		m.ancestors = t.ancestors(m.MIMEType)
+	}
+	sort.Stable(t.Magic)
+	sort.Stable(t.TreeMagic)
+	return t, nil
+}
+
+// ancestors returns the indices of the MIME types the one at index i
+// is a subclass of, directly or not.
+func (t *tables) ancestors(i int) []int {
+	var ancestors []int
+	queue := append([]int(nil), t.Types[t.TypeSlice[i]].subClassOf...)
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if n == i || containsInt(ancestors, n) {
+			continue
+		}
+		ancestors = append(ancestors, n)
+		queue = append(queue, t.Types[t.TypeSlice[n]].subClassOf...)
+	}
+	return ancestors
+}
+
+// setMediaTypes sets the indices of the MIME types the tree
+// matches check for.
+func setMediaTypes(matches []*parsedTreeMatch, types map[string]*parsedMIMEType) {
+	for _, m := range matches {
+		m.mediaType = -1
+		if p, ok := types[m.MIMEType]; ok {
+			m.mediaType = p.Lexicographic
+		}
+		setMediaTypes(m.TreeMatch, types)
+	}
+}
+
+func containsInt(s []int, n int) bool {
+	for _, i := range s {
+		if i == n {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// source is a parsed package file, kept to report problems with
+// the line they're on.
+type source struct {
+	filename string
+	info     *MIMEInfo
+	lines    []int64
+}
+
+func newSource(filename string, data []byte, info *MIMEInfo) source {
+	s := source{filename, info, []int64{0}}
+	for i, b := range data {
+		if b == '\n' {
+			s.lines = append(s.lines, int64(i+1))
+		}
+	}
+	return s
+}
+
+// position returns the location of the byte offset in the file.
+func (s source) position(offset int64) string {
+	line := sort.Search(len(s.lines), func(i int) bool { return s.lines[i] > offset })
+	return fmt.Sprintf("%s:%d", s.filename, line)
+}
+
+// Problem is a problem with a database found by Lint, at the
+// position of the element it's about, in the form "file:line".
+type Problem struct {
+	Position, Message string
+}
+
+func (p Problem) String() string {
+	return p.Position + ": " + p.Message
+}
+
+// typeNames maps the MIME types and their aliases to the name of
+// the MIME type, the first by name for aliases of several.
+func typeNames(types map[string]*parsedMIMEType) map[string]string {
+	names := make(map[string]string)
+	sorted := sortedTypes(types)
+	for _, t := range sorted {
+		for _, a := range types[t].Alias {
+			if _, ok := names[a]; !ok {
+				names[a] = t
+			}
+		}
+	}
+	for _, t := range sorted {
+		names[t] = t
+	}
+	return names
+}
+
+// Lint checks the package files that make up the database for
+// duplicate magic signatures, sub-class-of cycles, unknown parents,
+// aliases that collide with MIME types or with each other, and glob
+// patterns shadowed by the same pattern of a higher weight, of MIME
+// types without magic to pick them out.
+func (db *Database) Lint() []Problem {
+	types := db.withDefaults()
+	names := typeNames(types)
+	name := func(m *MIMEType) string {
+		if t, ok := names[m.Type]; ok {
+			return t
+		}
+		return m.Type
+	}
+	var problems []Problem
+	report := func(position, format string, a ...interface{}) {
+		problems = append(problems, Problem{position, fmt.Sprintf(format, a...)})
+	}
+
+	type globKey struct {
+		pattern       string
+		caseSensitive bool
+	}
+	type edge struct{ child, parent string }
+	signatures := make(map[string]string)
+	aliases := make(map[string]string)
+	aliasPositions := make(map[string]string)
+	edges := make(map[edge]string)
+	weights := make(map[globKey]map[string]int)
+	for _, s := range db.sources {
+		for _, m := range s.info.MIMEType {
+			t := name(m)
+			for _, mm := range m.Magic {
+				p, _ := parseMagic(mm)
+				key := t + " " + p.matchersString()
+				if first, ok := signatures[key]; ok {
+					report(s.position(mm.offset), "duplicate magic signature of %s, first at %s", t, first)
+					continue
+				}
+				signatures[key] = s.position(mm.offset)
+			}
+			for _, a := range m.Alias {
+				switch other, ok := aliases[a.Type]; {
+				case types[a.Type] != nil:
+					report(s.position(a.offset), "alias %s of %s is a MIME type", a.Type, m.Type)
+				case ok && other != t:
+					report(s.position(a.offset), "alias %s of %s is an alias of %s as well, at %s", a.Type, m.Type, other, aliasPositions[a.Type])
+				case !ok:
+					aliases[a.Type], aliasPositions[a.Type] = t, s.position(a.offset)
+				}
+			}
+			for _, sc := range m.SubClassOf {
+				parent, ok := names[sc.Type]
+				if !ok {
+					report(s.position(sc.offset), "%s is a subclass of unknown MIME type %s", m.Type, sc.Type)
+					continue
+				}
+				if _, ok := edges[edge{t, parent}]; !ok {
+					edges[edge{t, parent}] = s.position(sc.offset)
+				}
+			}
+			for _, g := range m.Glob {
+				key := globKey{g.Pattern, g.CaseSensitive}
+				if !g.CaseSensitive {
+					key.pattern = strings.ToLower(key.pattern)
+				}
+				if weights[key] == nil {
+					weights[key] = make(map[string]int)
+				}
+				if w := getPriority(g.Weight); w > weights[key][t] {
+					weights[key][t] = w
+				}
+			}
+		}
+	}
+
+	shadowed := make(map[globKey]map[string]bool)
+	for _, s := range db.sources {
+		for _, m := range s.info.MIMEType {
+			t := name(m)
+			for _, g := range m.Glob {
+				key := globKey{g.Pattern, g.CaseSensitive}
+				if !g.CaseSensitive {
+					key.pattern = strings.ToLower(key.pattern)
+				}
+				if shadowed[key][t] || types[t] != nil && len(types[t].Magic) > 0 {
+					continue
+				}
+				var by []string
+				for other, w := range weights[key] {
+					if w > weights[key][t] {
+						by = append(by, fmt.Sprintf("%s (weight %d)", other, w))
+					}
+				}
+				if len(by) == 0 {
+					continue
+				}
+				sort.Strings(by)
+				report(s.position(g.offset), "glob %s of %s (weight %d) is shadowed by %s", g.Pattern, t, weights[key][t], strings.Join(by, ", "))
+				if shadowed[key] == nil {
+					shadowed[key] = make(map[string]bool)
+				}
+				shadowed[key][t] = true
+			}
+		}
+	}
+
+	typeSlice := sortedTypes(types)
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var stack []string
+	var visit func(t string)
+	visit = func(t string) {
+		state[t] = visiting
+		stack = append(stack, t)
+		for _, sc := range types[t].SubClassOf {
+			parent, ok := names[sc]
+			if !ok {
+				continue
+			}
+			switch state[parent] {
+			case unvisited:
+				visit(parent)
+			case visiting:
+				i := len(stack) - 1
+				for stack[i] != parent {
+					i--
+				}
+				cycle := append(append([]string(nil), stack[i:]...), parent)
+				report(edges[edge{t, parent}], "sub-class-of cycle %s", strings.Join(cycle, " -> "))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[t] = visited
+	}
+	for _, t := range typeSlice {
+		if state[t] == unvisited {
+			visit(t)
+		}
+	}
+	return problems
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lint")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<mime-info xmlns="http://www.freedesktop.org/standards/shared-mime-info">
+  <mime-type type="text/plain">
+    <glob pattern="*.txt"/>
+  </mime-type>
+  <mime-type type="text/x-a">
+    <sub-class-of type="text/x-b"/>
+    <alias type="text/x-alias"/>
+    <glob pattern="*.txt" weight="40"/>
+    <magic><match type="string" value="a" offset="0"/></magic>
+    <magic priority="80"><match type="string" value="a" offset="0"/></magic>
+  </mime-type>
+  <mime-type type="text/x-b">
+    <sub-class-of type="text/x-a"/>
+    <sub-class-of type="text/x-missing"/>
+    <glob pattern="*.B" weight="10"/>
+  </mime-type>
+  <mime-type type="text/x-c">
+    <glob pattern="*.b" weight="20"/>
+  </mime-type>
+  <mime-type type="text/x-d">
+    <alias type="text/plain"/>
+  </mime-type>
+  <mime-type type="text/x-e">
+    <alias type="text/x-alias"/>
+  </mime-type>
+</mime-info>
+`
+	filename := filepath.Join(dir, "freedesktop.org.xml")
+	if err := ioutil.WriteFile(filename, []byte(data), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	db, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	var got []string
+	for _, p := range db.Lint() {
+		got = append(got, p.String())
+	}
+	want := []string{
+		filename + ":11: duplicate magic signature of text/x-a, first at " + filename + ":10",
+		filename + ":15: text/x-b is a subclass of unknown MIME type text/x-missing",
+		filename + ":22: alias text/plain of text/x-d is a MIME type",
+		filename + ":25: alias text/x-alias of text/x-e is an alias of text/x-a as well, at " + filename + ":8",
+		filename + ":16: glob *.B of text/x-b (weight 10) is shadowed by text/x-c (weight 20)",
+		filename + ":14: sub-class-of cycle text/x-a -> text/x-b -> text/x-a",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lint() =\n%q\nwant\n%q", got, want)
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package parser
 
 import (
 	"encoding/binary"
@@ -244,7 +244,45 @@ func (p *parsedMIMEType) merge(n *parsedMIMEType) {
 	}
 }
 
-func parseMIMEInfo(m *mimeInfo) (parsedMIMEInfo, error) {
+// clone returns a copy of the MIME type, with copies of the
+// signatures and root elements that generating the tables fills in
+// the indices of, leaving the database as it was.
+func (p *parsedMIMEType) clone() *parsedMIMEType {
+	c := *p
+	c.subClassOf = nil
+	c.Magic = make([]*parsedMagic, len(p.Magic))
+	for i, m := range p.Magic {
+		mm := *m
+		c.Magic[i] = &mm
+	}
+	c.RootXML = make([]*parsedRootXML, len(p.RootXML))
+	for i, x := range p.RootXML {
+		xx := *x
+		c.RootXML[i] = &xx
+	}
+	c.TreeMagic = make([]*parsedTreeMagic, len(p.TreeMagic))
+	for i, m := range p.TreeMagic {
+		mm := *m
+		mm.TreeMatch = cloneTreeMatches(m.TreeMatch)
+		c.TreeMagic[i] = &mm
+	}
+	return &c
+}
+
+func cloneTreeMatches(matches []*parsedTreeMatch) []*parsedTreeMatch {
+	if matches == nil {
+		return nil
+	}
+	c := make([]*parsedTreeMatch, len(matches))
+	for i, m := range matches {
+		mm := *m
+		mm.TreeMatch = cloneTreeMatches(m.TreeMatch)
+		c[i] = &mm
+	}
+	return c
+}
+
+func parseMIMEInfo(m *MIMEInfo) (parsedMIMEInfo, error) {
 	if len(m.MIMEType) < 1 {
 		return nil, errors.New("<mime-info> must contain at least one <mime-type> element")
 	}
@@ -260,7 +298,7 @@ func parseMIMEInfo(m *mimeInfo) (parsedMIMEInfo, error) {
 	return p, nil
 }
 
-func parseMIMEType(m *mimeType) (*parsedMIMEType, error) {
+func parseMIMEType(m *MIMEType) (*parsedMIMEType, error) {
 	s := strings.Split(m.Type, "/")
 	if len(s) != 2 {
 		return nil, fmt.Errorf("unknown media type in type '%s'", m.Type)
@@ -343,7 +381,7 @@ outer:
 	return p, nil
 }
 
-func parseRootXML(r *rootXML) (*parsedRootXML, error) {
+func parseRootXML(r *RootXML) (*parsedRootXML, error) {
 	if r.NamespaceURI+r.LocalName == "" {
 		return nil, errors.New("namespaceURI and localName attributes can't both be empty")
 	}
@@ -356,7 +394,7 @@ func parseRootXML(r *rootXML) (*parsedRootXML, error) {
 	}, nil
 }
 
-func parseTreeMagic(t *treeMagic) (p *parsedTreeMagic, err error) {
+func parseTreeMagic(t *TreeMagic) (p *parsedTreeMagic, err error) {
 	p = &parsedTreeMagic{Priority: getPriority(t.Priority)}
 	if p.Priority == invalidPriority {
 		return nil, errors.New("invalid tree magic priority")
@@ -371,7 +409,7 @@ func parseTreeMagic(t *treeMagic) (p *parsedTreeMagic, err error) {
 	return
 }
 
-func parseTreeMatch(t *treeMatch) (*parsedTreeMatch, error) {
+func parseTreeMatch(t *TreeMatch) (*parsedTreeMatch, error) {
 	if t.Path == "" {
 		return nil, errors.New("missing 'path' attribute in <treematch>")
 	}
@@ -380,6 +418,7 @@ func parseTreeMatch(t *treeMatch) (*parsedTreeMatch, error) {
 		MatchCase:  t.MatchCase,
 		Executable: t.Executable,
 		NonEmpty:   t.NonEmpty,
+		MIMEType:   t.MIMEType,
 	}
 	switch t.Type {
 	case "":
@@ -414,7 +453,7 @@ func getPriority(p *int) int {
 	return i
 }
 
-func parseGlob(g *glob) (p *parsedGlob, err error) {
+func parseGlob(g *Glob) (p *parsedGlob, err error) {
 	p = &parsedGlob{
 		Weight:        getPriority(g.Weight),
 		CaseSensitive: g.CaseSensitive,
@@ -452,7 +491,7 @@ func mergeGlobs(pp ...*parsedGlob) []*parsedGlob {
 	return p
 }
 
-func parseMagic(m *magic) (p *parsedMagic, err error) {
+func parseMagic(m *Magic) (p *parsedMagic, err error) {
 	p = &parsedMagic{Priority: getPriority(m.Priority)}
 	if p.Priority == invalidPriority {
 		return nil, errors.New("invalid magic priority")
@@ -467,7 +506,7 @@ func parseMagic(m *magic) (p *parsedMagic, err error) {
 	return
 }
 
-func parseMatch(m *match) (p *parsedMatch, err error) {
+func parseMatch(m *Match) (p *parsedMatch, err error) {
 	if m.Offset == "" {
 		return nil, errors.New("missing 'offset' attribute")
 	}
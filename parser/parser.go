@@ -0,0 +1,199 @@
+// Package parser parses shared-mime-info package files into a MIME
+// type database, and generates the tables of package mimemagic
+// from it.
+//
+// Databases are loaded from the packages directories of the XDG
+// data directories:
+//
+//	db, err := parser.Load("/usr/share/mime/packages")
+//	if err != nil {
+//		return err
+//	}
+//	err = db.Generate(w, parser.Options{})
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Database is a MIME type database, merged from shared-mime-info
+// package files.
+type Database struct {
+	types   map[string]*parsedMIMEType
+	sources []source
+	hash    hash.Hash
+}
+
+// Load loads the *.xml package files in each of the directories
+// into a new database, in order.
+func Load(dirs ...string) (*Database, error) {
+	db := &Database{types: make(map[string]*parsedMIMEType)}
+	for _, dir := range dirs {
+		if err := db.LoadDir(dir); err != nil {
+			return nil, err
+		}
+	}
+	return db, nil
+}
+
+// LoadDir loads the *.xml package files in dir into the database,
+// freedesktop.org.xml first and Override.xml last.
+func (db *Database) LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.xml"))
+	if err != nil {
+		return fmt.Errorf("invalid directory name %s: %v", dir, err)
+	}
+	if len(files) < 1 {
+		return fmt.Errorf("no *.xml files found in %s", dir)
+	}
+	switch _, err = os.Stat(filepath.Join(dir, "freedesktop.org.xml")); {
+	case err == nil:
+		if err = db.LoadFile(filepath.Join(dir, "freedesktop.org.xml")); err != nil {
+			return err
+		}
+	case os.IsNotExist(err):
+		break
+	default:
+		return fmt.Errorf("couldn't open file freedesktop.org.xml: %v", err)
+	}
+	var override string
+	for _, filename := range files {
+		switch filepath.Base(filename) {
+		case "freedesktop.org.xml":
+			continue
+		case "Override.xml":
+			override = filename
+		default:
+			if err = db.LoadFile(filename); err != nil {
+				return err
+			}
+		}
+	}
+	if override != "" {
+		return db.LoadFile(override)
+	}
+	return nil
+}
+
+// LoadFile loads the package file into the database, merging the
+// MIME types in it with those already loaded.
+func (db *Database) LoadFile(filename string) error {
+	fn := filepath.Base(filename)
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("couldn't open file %s: %v", fn, err)
+	}
+	m := &MIMEInfo{}
+	if err = xml.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("couldn't unmarshal xml in file %s: %v", fn, err)
+	}
+	p, err := parseMIMEInfo(m)
+	if err != nil {
+		return fmt.Errorf("couldn't parse MIME info in file %s: %v", fn, err)
+	}
+	db.sources = append(db.sources, newSource(filename, data, m))
+	if db.hash == nil {
+		db.hash = sha256.New()
+	}
+	fmt.Fprintf(db.hash, "%s\x00%d\x00", fn, len(data))
+	db.hash.Write(data)
+	db.insert(p)
+	return nil
+}
+
+func (db *Database) insert(mi parsedMIMEInfo) {
+	for _, mt := range mi {
+		if ot := db.mergedInto(mt); ot != nil {
+			ot.merge(mt)
+		} else {
+			db.types[mt.Media+"/"+mt.Subtype] = mt
+		}
+	}
+}
+
+// mergedInto returns the MIME type of the database a definition is
+// merged into: the one of the same name, the one named by one of its
+// aliases, or else the first one by name that has its name or one of
+// its aliases as an alias. It returns nil for a new MIME type.
+func (db *Database) mergedInto(mt *parsedMIMEType) *parsedMIMEType {
+	name := mt.Media + "/" + mt.Subtype
+	if ot, ok := db.types[name]; ok {
+		return ot
+	}
+	for _, a := range mt.Alias {
+		if ot, ok := db.types[a]; ok {
+			return ot
+		}
+	}
+	for _, t := range sortedTypes(db.types) {
+		ot := db.types[t]
+		for _, a := range ot.Alias {
+			if a == name || containsString(mt.Alias, a) {
+				return ot
+			}
+		}
+	}
+	return nil
+}
+
+// hashSum returns the SHA-256 checksum of the package files loaded
+// into the database, in order, along with their names.
+func (db *Database) hashSum() string {
+	if db.hash == nil {
+		db.hash = sha256.New()
+	}
+	return fmt.Sprintf("%x", db.hash.Sum(nil))
+}
+
+// defaultTypes are the MIME types the tables can't do without,
+// added to the database if it doesn't define them.
+var defaultTypes = []parsedMIMEType{
+	{Media: "application", Subtype: "octet-stream", Comment: "unknown"},
+	{Media: "application", Subtype: "x-zerosize", Comment: "empty document"},
+	{Media: "text", Subtype: "plain", Comment: "plain text document"},
+	{Media: "application", Subtype: "xml", Comment: "XML document"},
+	{Media: "inode", Subtype: "directory", Comment: "folder"},
+}
+
+// withDefaults returns the MIME types of the database along with
+// any missing default ones.
+func (db *Database) withDefaults() map[string]*parsedMIMEType {
+	types := make(map[string]*parsedMIMEType, len(db.types)+len(defaultTypes))
+	for t, p := range db.types {
+		types[t] = p
+	}
+	for i := range defaultTypes {
+		t := defaultTypes[i]
+		if _, ok := types[t.Media+"/"+t.Subtype]; !ok {
+			types[t.Media+"/"+t.Subtype] = &t
+		}
+	}
+	return types
+}
+
+// sortedTypes returns the names of the MIME types, sorted, so that
+// nothing depends on the order of map iteration.
+func sortedTypes(types map[string]*parsedMIMEType) []string {
+	names := make([]string, 0, len(types))
+	for t := range types {
+		names = append(names, t)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func containsString(s []string, str string) bool {
+	for _, ss := range s {
+		if ss == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package parser
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+var generatedHeader = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// generated checks the header of the generated source, and returns
+// the rest.
+func generated(t *testing.T, src string) string {
+	i := strings.Index(src, "\n\n")
+	if i < 0 || !generatedHeader.MatchString(src[:i]) {
+		t.Errorf("Generate() header missing from %v", src)
+		return src
+	}
+	return src[i+2:]
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "load")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if _, err = Load(dir); err == nil {
+		t.Errorf("Load() of an empty directory error = nil")
+	}
+	for name, data := range map[string]string{
+		"freedesktop.org.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<mime-info xmlns="http://www.freedesktop.org/standards/shared-mime-info">
+  <mime-type type="text/x-a">
+    <glob pattern="*.a"/>
+  </mime-type>
+</mime-info>
+`,
+		"Override.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<mime-info xmlns="http://www.freedesktop.org/standards/shared-mime-info">
+  <mime-type type="text/x-a">
+    <magic><match type="string" value="a" offset="0"/></magic>
+  </mime-type>
+</mime-info>
+`,
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	db, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	tables, err := db.Tables()
+	if err != nil {
+		t.Fatalf("Tables() error = %v", err)
+	}
+	if err = mimemagic.Register(tables); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	defer registerShipped(t)
+	if got := mimemagic.Match([]byte("a"), "x.a"); got.MediaType() != "text/x-a" {
+		t.Errorf("Match() = %v, want text/x-a", got.MediaType())
+	}
+	var b bytes.Buffer
+	if err = db.Generate(&b, Options{Tables: MagicTable}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	want := `package mimemagic
+
+var magicMaxLen = 1
+
+var magicSignatures = []magic{
+	{5, 50, []*magicMatch{{0, 0, []byte{0x61}, nil, nil}}},
+}
+`
+	if got := generated(t, b.String()); got != want {
+		t.Errorf("Generate() = %v, want %v", got, want)
+	}
+	b.Reset()
+	if err = db.Generate(&b, Options{Tables: MagicTable, Package: "ourmime"}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	want = `package ourmime
+
+import "github.com/zRedShift/mimemagic/v2"
+
+var magicSignatures = []mimemagic.TableMagic{
+	{MediaType: 5, MagicSignature: mimemagic.MagicSignature{Priority: 50, Rules: []mimemagic.MagicRule{{Value: []byte{0x61}}}}},
+}
+`
+	if got := generated(t, b.String()); got != want {
+		t.Errorf("Generate() = %v, want %v", got, want)
+	}
+	if err = db.Generate(&b, Options{Package: "our-mime"}); err == nil {
+		t.Errorf("Generate() with an invalid package name error = nil")
+	}
+	var first, second bytes.Buffer
+	if err = db.Generate(&first, Options{}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if db, err = Load(dir); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if err = db.Generate(&second, Options{}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if first.String() != second.String() {
+		t.Errorf("Generate() isn't deterministic: %v != %v", first.String(), second.String())
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "broken.xml"), []byte("<mime-info"), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err = Load(dir); err == nil {
+		t.Errorf("Load() of a broken package file error = nil")
+	}
+}
+
+func TestGenerateTies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ties")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<mime-info xmlns="http://www.freedesktop.org/standards/shared-mime-info">
+  <mime-type type="text/x-a">
+    <sub-class-of type="text/x-b"/>
+    <magic><match type="string" value="a" offset="0"/></magic>
+  </mime-type>
+  <mime-type type="text/x-b">
+    <magic><match type="string" value="a" offset="0"/></magic>
+  </mime-type>
+  <mime-type type="text/x-c">
+    <magic><match type="string" value="a" offset="0"/></magic>
+  </mime-type>
+</mime-info>
+`
+	if err = ioutil.WriteFile(filepath.Join(dir, "freedesktop.org.xml"), []byte(data), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	db, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	var b bytes.Buffer
+	if err = db.Generate(&b, Options{Tables: MagicTable}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	// The subclass text/x-a stays ahead of text/x-b, and else the
+	// later MIME type comes first.
+	want := `package mimemagic
+
+var magicMaxLen = 1
+
+var magicSignatures = []magic{
+	{7, 50, []*magicMatch{{0, 0, []byte{0x61}, nil, nil}}},
+	{5, 50, []*magicMatch{{0, 0, []byte{0x61}, nil, nil}}},
+	{6, 50, []*magicMatch{{0, 0, []byte{0x61}, nil, nil}}},
+}
+`
+	if got := generated(t, b.String()); got != want {
+		t.Errorf("Generate() = %v, want %v", got, want)
+	}
+}
+
+// TestGenerate checks that the tables of the mimemagic package are
+// up to date with the package files in cmd/parser.
+func TestGenerate(t *testing.T) {
+	db, err := Load("../cmd/parser")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for name, table := range map[string]Table{
+		"mediatypes.go":    MediaTypesTable,
+		"globs.go":         GlobsTable,
+		"magicsigs.go":     MagicTable,
+		"treemagicsigs.go": TreeMagicTable,
+		"namespaces.go":    NamespacesTable,
+	} {
+		want, err := ioutil.ReadFile(filepath.Join("..", name))
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		var b bytes.Buffer
+		if err = db.Generate(&b, Options{Tables: table}); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		if !bytes.Equal(b.Bytes(), want) {
+			t.Errorf("%s is out of date", name)
+		}
+	}
+}
+
+func TestGenerateUnchanged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unchanged")
+	if err != nil {
+		t.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<mime-info xmlns="http://www.freedesktop.org/standards/shared-mime-info">
+  <mime-type type="text/x-a">
+    <sub-class-of type="text/plain"/>
+    <magic><match type="string" value="a" offset="0"/></magic>
+    <magic priority="80"><match type="string" value="a" offset="0"/></magic>
+    <root-XML namespaceURI="urn:a" localName="a"/>
+  </mime-type>
+  <mime-type type="x-content/x-a">
+    <treemagic><treematch path="a" type="file" mimetype="text/x-a"/></treemagic>
+  </mime-type>
+</mime-info>
+`
+	if err = ioutil.WriteFile(filepath.Join(dir, "freedesktop.org.xml"), []byte(data), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	db, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if err = db.Generate(ioutil.Discard, Options{}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !reflect.DeepEqual(db.types, want.types) {
+		t.Errorf("Generate() changed the database")
+	}
+}
+
+func TestDatabase_insert(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		db := &Database{types: map[string]*parsedMIMEType{
+			"text/x-b": {Media: "text", Subtype: "x-b", Alias: []string{"text/x-shared"}},
+			"text/x-a": {Media: "text", Subtype: "x-a", Alias: []string{"text/x-shared"}},
+		}}
+		db.insert(parsedMIMEInfo{{Media: "text", Subtype: "x-c", Alias: []string{"text/x-shared"}, Extension: []string{".c"}}})
+		if len(db.types) != 2 || len(db.types["text/x-a"].Extension) != 1 || len(db.types["text/x-b"].Extension) != 0 {
+			t.Fatalf("insert() didn't merge text/x-c into text/x-a")
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The Qualified methods return the Go source of the tables of a
+// package other than mimemagic, in terms of the exported types of
+// package mimemagic that make up mimemagic.Tables. The composite
+// literals are keyed, as go vet expects of another package's types,
+// and zero fields are left out.
+
+// fields are the keyed fields of a composite literal.
+type fields []string
+
+func (f *fields) add(key, value string) {
+	if value != "" && value != nilString && value != "0" && value != "false" && value != `""` {
+		*f = append(*f, key+": "+value)
+	}
+}
+
+func (f fields) String() string {
+	return "{" + strings.Join(f, ", ") + "}"
+}
+
+func (p *parsedMIMEType) Qualified() string {
+	var f fields
+	slice := func(v []string) string {
+		if len(v) == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%#v", v)
+	}
+	f.add("Media", fmt.Sprintf("%q", p.Media))
+	f.add("Subtype", fmt.Sprintf("%q", p.Subtype))
+	f.add("Comment", fmt.Sprintf("%q", p.Comment))
+	f.add("Acronym", fmt.Sprintf("%q", p.Acronym))
+	f.add("ExpandedAcronym", fmt.Sprintf("%q", p.ExpandedAcronym))
+	f.add("Icon", fmt.Sprintf("%q", p.Icon))
+	f.add("GenericIcon", fmt.Sprintf("%q", p.GenericIcon))
+	f.add("Alias", slice(p.Alias))
+	f.add("SubClassOf", slice(p.SubClassOf))
+	f.add("Extensions", slice(p.Extension))
+	return f.String()
+}
+
+// typeGlob is a glob pattern of the MIME type at index MIMEType.
+type typeGlob struct {
+	*parsedGlob
+	MIMEType int
+}
+
+func (p typeGlob) Qualified() string {
+	var g fields
+	g.add("Pattern", fmt.Sprintf("%q", p.Pattern))
+	g.add("Weight", fmt.Sprint(p.Weight))
+	g.add("CaseSensitive", fmt.Sprint(p.CaseSensitive))
+	return qualifiedEntry(p.MIMEType, "GlobPattern", g)
+}
+
+func (p *parsedMagic) Qualified() string {
+	var m fields
+	m.add("Priority", fmt.Sprint(p.Priority))
+	m.add("Rules", qualifiedMatches(p.Match))
+	return qualifiedEntry(p.MIMEType, "MagicSignature", m)
+}
+
+func qualifiedMatches(matches []*parsedMatch) string {
+	if len(matches) == 0 {
+		return nilString
+	}
+	s := make([]string, 0, len(matches))
+	for _, m := range matches {
+		var f fields
+		f.add("Offset", fmt.Sprint(m.RangeStart))
+		f.add("Range", fmt.Sprint(m.RangeLength))
+		f.add("Value", fmt.Sprintf("%#v", m.Data))
+		if len(m.Mask) > 0 {
+			f.add("Mask", fmt.Sprintf("%#v", m.Mask))
+		}
+		f.add("Next", qualifiedMatches(m.Match))
+		s = append(s, f.String())
+	}
+	return fmt.Sprintf("[]mimemagic.MagicRule{%s}", strings.Join(s, ", "))
+}
+
+func (p *parsedRootXML) Qualified() string {
+	var x fields
+	x.add("NamespaceURI", fmt.Sprintf("%q", p.NamespaceURI))
+	x.add("LocalName", fmt.Sprintf("%q", p.LocalName))
+	return qualifiedEntry(p.MIMEType, "XMLRoot", x)
+}
+
+func (p *parsedTreeMagic) Qualified() string {
+	var t fields
+	t.add("Priority", fmt.Sprint(p.Priority))
+	t.add("Rules", qualifiedTreeMatches(p.TreeMatch))
+	return qualifiedEntry(p.MIMEType, "TreeMagicSignature", t)
+}
+
+var treeMatchTypes = [...]string{"", "file", "directory", "link"}
+
+func qualifiedTreeMatches(matches []*parsedTreeMatch) string {
+	if len(matches) == 0 {
+		return nilString
+	}
+	s := make([]string, 0, len(matches))
+	for _, m := range matches {
+		var f fields
+		f.add("Path", fmt.Sprintf("%q", m.Path))
+		f.add("Type", fmt.Sprintf("%q", treeMatchTypes[m.Type]))
+		if m.mediaType > -1 {
+			f.add("MediaType", fmt.Sprintf("%q", m.MIMEType))
+		}
+		f.add("MatchCase", fmt.Sprint(m.MatchCase))
+		f.add("Executable", fmt.Sprint(m.Executable))
+		f.add("NonEmpty", fmt.Sprint(m.NonEmpty))
+		f.add("Next", qualifiedTreeMatches(m.TreeMatch))
+		s = append(s, f.String())
+	}
+	return fmt.Sprintf("[]mimemagic.TreeMagicRule{%s}", strings.Join(s, ", "))
+}
+
+// qualifiedEntry returns an entry of a table of mimemagic.Tables,
+// of the MIME type at index mimeType, embedding the value of type
+// mimemagic.name.
+func qualifiedEntry(mimeType int, name string, value fields) string {
+	return fmt.Sprintf("{MediaType: %d, %s: mimemagic.%s%s}", mimeType, name, name, value)
+}
@@ -0,0 +1,112 @@
+package parser
+
+import "github.com/zRedShift/mimemagic/v2"
+
+// registered are the tables of a database as the values of the
+// exported types of package mimemagic, the same the Qualified
+// methods write the Go source of.
+type registered struct {
+	mediaTypes []mimemagic.MediaType
+	globs      []mimemagic.TableGlob
+	magic      []mimemagic.TableMagic
+	roots      []mimemagic.TableXMLRoot
+	treeMagic  []mimemagic.TableTreeMagic
+}
+
+func (r *registered) MediaTypes() []mimemagic.MediaType { return r.mediaTypes }
+
+func (r *registered) Globs() []mimemagic.TableGlob { return r.globs }
+
+func (r *registered) Magic() []mimemagic.TableMagic { return r.magic }
+
+func (r *registered) XMLRoots() []mimemagic.TableXMLRoot { return r.roots }
+
+func (r *registered) TreeMagic() []mimemagic.TableTreeMagic { return r.treeMagic }
+
+// Tables returns the tables of the database, the same Generate
+// writes, for mimemagic.Register, so that the database can be
+// matched against without generating them first.
+func (db *Database) Tables() (mimemagic.Tables, error) {
+	t, err := db.tables()
+	if err != nil {
+		return nil, err
+	}
+	r := new(registered)
+	for _, name := range t.TypeSlice {
+		p := t.Types[name]
+		r.mediaTypes = append(r.mediaTypes, mimemagic.MediaType{
+			Media:           p.Media,
+			Subtype:         p.Subtype,
+			Comment:         p.Comment,
+			Acronym:         p.Acronym,
+			ExpandedAcronym: p.ExpandedAcronym,
+			Icon:            p.Icon,
+			GenericIcon:     p.GenericIcon,
+			Alias:           p.Alias,
+			SubClassOf:      p.SubClassOf,
+			Extensions:      p.Extension,
+		})
+	}
+	for _, g := range t.TypeGlobs {
+		r.globs = append(r.globs, mimemagic.TableGlob{MediaType: g.MIMEType, GlobPattern: mimemagic.GlobPattern{
+			Pattern:       g.Pattern,
+			Weight:        g.Weight,
+			CaseSensitive: g.CaseSensitive,
+		}})
+	}
+	for _, m := range t.Magic {
+		r.magic = append(r.magic, mimemagic.TableMagic{MediaType: m.MIMEType, MagicSignature: mimemagic.MagicSignature{
+			Priority: m.Priority,
+			Rules:    magicRules(m.Match),
+		}})
+	}
+	for _, x := range t.RootXML {
+		r.roots = append(r.roots, mimemagic.TableXMLRoot{MediaType: x.MIMEType, XMLRoot: mimemagic.XMLRoot{
+			NamespaceURI: x.NamespaceURI,
+			LocalName:    x.LocalName,
+		}})
+	}
+	for _, m := range t.TreeMagic {
+		r.treeMagic = append(r.treeMagic, mimemagic.TableTreeMagic{MediaType: m.MIMEType, TreeMagicSignature: mimemagic.TreeMagicSignature{
+			Priority: m.Priority,
+			Rules:    treeMagicRules(m.TreeMatch),
+		}})
+	}
+	return r, nil
+}
+
+func magicRules(matches []*parsedMatch) []mimemagic.MagicRule {
+	var rules []mimemagic.MagicRule
+	for _, m := range matches {
+		rule := mimemagic.MagicRule{
+			Offset: m.RangeStart,
+			Range:  m.RangeLength,
+			Value:  m.Data,
+			Next:   magicRules(m.Match),
+		}
+		if len(m.Mask) > 0 {
+			rule.Mask = m.Mask
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func treeMagicRules(matches []*parsedTreeMatch) []mimemagic.TreeMagicRule {
+	var rules []mimemagic.TreeMagicRule
+	for _, m := range matches {
+		rule := mimemagic.TreeMagicRule{
+			Path:       m.Path,
+			Type:       treeMatchTypes[m.Type],
+			MatchCase:  m.MatchCase,
+			Executable: m.Executable,
+			NonEmpty:   m.NonEmpty,
+			Next:       treeMagicRules(m.TreeMatch),
+		}
+		if m.mediaType > -1 {
+			rule.MediaType = m.MIMEType
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zRedShift/mimemagic/v2"
+)
+
+// registerShipped registers the tables of the database in cmd/parser,
+// which the tables of package mimemagic are generated from, again.
+func registerShipped(t *testing.T) {
+	db, err := Load("../cmd/parser")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	tables, err := db.Tables()
+	if err != nil {
+		t.Fatalf("Tables() error = %v", err)
+	}
+	if err = mimemagic.Register(tables); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+}
+
+// TestTables checks that the tables of the database in cmd/parser
+// are those generated into package mimemagic.
+func TestTables(t *testing.T) {
+	type database struct {
+		MediaTypes []mimemagic.MediaType
+		Globs      [][]mimemagic.GlobPattern
+		Magic      [][]mimemagic.MagicSignature
+		XMLRoots   [][]mimemagic.XMLRoot
+		TreeMagic  [][]mimemagic.TreeMagicSignature
+	}
+	registeredDatabase := func() database {
+		var d database
+		d.MediaTypes = mimemagic.MediaTypes()
+		for _, m := range d.MediaTypes {
+			d.Globs = append(d.Globs, m.Globs())
+			d.Magic = append(d.Magic, m.Magic())
+			d.XMLRoots = append(d.XMLRoots, m.XMLRoots())
+			d.TreeMagic = append(d.TreeMagic, m.TreeMagic())
+		}
+		return d
+	}
+	want := registeredDatabase()
+	registerShipped(t)
+	if got := registeredDatabase(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tables() differ from the generated tables")
+	}
+}
@@ -1,79 +1,112 @@
-package main
+package parser
 
 import (
+	"encoding/xml"
 	"fmt"
 	"math"
 	"strings"
 )
 
-type mimeInfo struct {
+// MIMEInfo is a shared-mime-info package file.
+type MIMEInfo struct {
 	XMLName  struct{}    `xml:"http://www.freedesktop.org/standards/shared-mime-info mime-info"`
-	MIMEType []*mimeType `xml:"mime-type"`
+	MIMEType []*MIMEType `xml:"mime-type"`
 }
 
-type mimeType struct {
+// MIMEType is a mime-type element of a package file.
+type MIMEType struct {
 	Type            string           `xml:"type,attr"`
-	Comment         []*comment       `xml:"comment"`
-	Acronym         *acronym         `xml:"acronym,omitempty"`
-	ExpandedAcronym *expandedAcronym `xml:"expanded-acronym,omitempty"`
-	Icon            *icon            `xml:"icon,omitempty"`
-	GenericIcon     *genericIcon     `xml:"generic-icon,omitempty"`
-	Glob            []*glob          `xml:"glob,omitempty"`
-	Magic           []*magic         `xml:"magic,omitempty"`
-	TreeMagic       []*treeMagic     `xml:"treemagic,omitempty"`
-	RootXML         []*rootXML       `xml:"root-XML,omitempty"`
-	Alias           []*alias         `xml:"alias,omitempty"`
-	SubClassOf      []*subClassOf    `xml:"sub-class-of,omitempty"`
-}
-
-type comment struct {
+	Comment         []*Comment       `xml:"comment"`
+	Acronym         *Acronym         `xml:"acronym,omitempty"`
+	ExpandedAcronym *ExpandedAcronym `xml:"expanded-acronym,omitempty"`
+	Icon            *Icon            `xml:"icon,omitempty"`
+	GenericIcon     *GenericIcon     `xml:"generic-icon,omitempty"`
+	Glob            []*Glob          `xml:"glob,omitempty"`
+	Magic           []*Magic         `xml:"magic,omitempty"`
+	TreeMagic       []*TreeMagic     `xml:"treemagic,omitempty"`
+	RootXML         []*RootXML       `xml:"root-XML,omitempty"`
+	Alias           []*Alias         `xml:"alias,omitempty"`
+	SubClassOf      []*SubClassOf    `xml:"sub-class-of,omitempty"`
+}
+
+// Comment is the description of a MIME type, in the language Lang.
+type Comment struct {
 	Value string `xml:",chardata"`
 	Lang  string `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
 }
 
-type acronym struct {
+// Acronym is the acronym of a MIME type, in the language Lang.
+type Acronym struct {
 	Value string `xml:",chardata"`
 	Lang  string `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
 }
-type expandedAcronym struct {
+
+// ExpandedAcronym is the expanded acronym of a MIME type, in the
+// language Lang.
+type ExpandedAcronym struct {
 	Value string `xml:",chardata"`
 	Lang  string `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
 }
 
-type icon struct {
+// Icon is the name of the icon of a MIME type.
+type Icon struct {
 	Name string `xml:"name,attr"`
 }
 
-type genericIcon struct {
+// GenericIcon is the name of the generic icon of a MIME type.
+type GenericIcon struct {
 	Name string `xml:"name,attr"`
 }
 
-type glob struct {
+// Glob is a glob pattern matching the file names of a MIME type.
+type Glob struct {
 	Pattern       string `xml:"pattern,attr"`
 	Weight        *int   `xml:"weight,attr,omitempty"`
 	CaseSensitive bool   `xml:"case-sensitive,attr,omitempty"`
+	offset        int64
+}
+
+// UnmarshalXML decodes the glob, recording its position in the file.
+func (g *Glob) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Glob
+	g.offset = d.InputOffset()
+	return d.DecodeElement((*plain)(g), &start)
 }
 
-type magic struct {
-	Match    []*match `xml:"match"`
+// Magic is a magic signature of a MIME type, any of whose match
+// trees matches.
+type Magic struct {
+	Match    []*Match `xml:"match"`
 	Priority *int     `xml:"priority,attr,omitempty"`
+	offset   int64
+}
+
+// UnmarshalXML decodes the magic, recording its position in the file.
+func (m *Magic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Magic
+	m.offset = d.InputOffset()
+	return d.DecodeElement((*plain)(m), &start)
 }
 
-type match struct {
-	Match  []*match `xml:"match,omitempty"`
+// Match is a magic rule, matching if it and any of its children match.
+type Match struct {
+	Match  []*Match `xml:"match,omitempty"`
 	Offset string   `xml:"offset,attr"`
 	Type   string   `xml:"type,attr"`
 	Value  string   `xml:"value,attr"`
 	Mask   string   `xml:"mask,attr,omitempty"`
 }
 
-type treeMagic struct {
-	TreeMatch []*treeMatch `xml:"treematch"`
+// TreeMagic is a tree magic signature of an x-content MIME type.
+type TreeMagic struct {
+	TreeMatch []*TreeMatch `xml:"treematch"`
 	Priority  *int         `xml:"priority,attr,omitempty"`
 }
 
-type treeMatch struct {
-	TreeMatch  []*treeMatch `xml:"treematch,omitempty"`
+// TreeMatch is a tree magic rule, matching if it and any of its
+// children match.
+type TreeMatch struct {
+	TreeMatch  []*TreeMatch `xml:"treematch,omitempty"`
 	Path       string       `xml:"path,attr"`
 	Type       string       `xml:"type,attr,omitempty"`
 	MatchCase  bool         `xml:"match-case,attr,omitempty"`
@@ -82,16 +115,38 @@ type treeMatch struct {
 	MIMEType   string       `xml:"mimetype,attr,omitempty"`
 }
 
-type rootXML struct {
+// RootXML is the namespace and local name of the root element of an
+// XML MIME type.
+type RootXML struct {
 	NamespaceURI string `xml:"namespaceURI,attr"`
 	LocalName    string `xml:"localName,attr"`
 }
 
-type alias struct {
-	Type string `xml:"type,attr"`
+// Alias is an alias of a MIME type.
+type Alias struct {
+	Type   string `xml:"type,attr"`
+	offset int64
+}
+
+// UnmarshalXML decodes the alias, recording its position in the file.
+func (a *Alias) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Alias
+	a.offset = d.InputOffset()
+	return d.DecodeElement((*plain)(a), &start)
+}
+
+// SubClassOf is a parent of a MIME type.
+type SubClassOf struct {
+	Type   string `xml:"type,attr"`
+	offset int64
 }
-type subClassOf struct {
-	Type string `xml:"type,attr"`
+
+// UnmarshalXML decodes the parent, recording its position in the
+// file.
+func (s *SubClassOf) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain SubClassOf
+	s.offset = d.InputOffset()
+	return d.DecodeElement((*plain)(s), &start)
 }
 
 type parsedMIMEInfo []*parsedMIMEType
@@ -104,6 +159,7 @@ type parsedMIMEType struct {
 	TreeMagic                                                            []*parsedTreeMagic
 	RootXML                                                              []*parsedRootXML
 	Lexicographic                                                        int
+	subClassOf                                                           []int
 }
 
 const nilString = "nil"
@@ -112,47 +168,11 @@ func (p *parsedMIMEType) String() string {
 	alias, subclass, ext, subint := nilString, nilString, nilString, nilString
 	if len(p.Alias) > 0 {
 		alias = fmt.Sprintf("%#v", p.Alias)
-		s := make([]int, 0, len(alias))
-		for _, a := range p.Alias {
-			if _, ok := types[a]; !ok {
-				continue
-			}
-			n := types[a].Lexicographic
-			unique := true
-			for _, ss := range s {
-				if ss == n {
-					unique = false
-					break
-				}
-			}
-			if unique {
-				s = append(s, n)
-			}
-		}
 	}
 	if len(p.SubClassOf) > 0 {
 		subclass = fmt.Sprintf("%#v", p.SubClassOf)
-		s := make([]int, 0, len(p.SubClassOf))
-		for _, sb := range p.SubClassOf {
-			if _, ok := types[sb]; !ok {
-				if sb, ok = aliases[sb]; !ok {
-					continue
-				}
-			}
-			n := types[sb].Lexicographic
-			unique := true
-			for _, ss := range s {
-				if ss == n {
-					unique = false
-					break
-				}
-			}
-			if unique {
-				s = append(s, n)
-			}
-		}
-		if len(s) > 0 {
-			subint = fmt.Sprintf("%#v", s)
+		if len(p.subClassOf) > 0 {
+			subint = fmt.Sprintf("%#v", p.subClassOf)
 		}
 	}
 	if len(p.Extension) > 0 {
@@ -171,6 +191,9 @@ type parsedMagic struct {
 	Priority int
 	MIMEType int
 	Match    []*parsedMatch
+	// ancestors are the MIME types MIMEType is a subclass of,
+	// directly or not.
+	ancestors []int
 }
 
 func (p *parsedMagic) MaxLen() int {
@@ -202,12 +225,17 @@ func (p *parsedMagic) MinPatternLen() int {
 }
 
 func (p *parsedMagic) String() string {
+	return fmt.Sprintf("{%d, %d, %s}", p.MIMEType, p.Priority, p.matchersString())
+}
+
+// matchersString returns the Go source of the matchers of the
+// signature, which identify it regardless of its priority.
+func (p *parsedMagic) matchersString() string {
 	s := make([]string, 0, len(p.Match))
 	for _, pp := range p.Match {
 		s = append(s, pp.String())
 	}
-	pMatch := fmt.Sprintf("[]*magicMatch{%s}", strings.Join(s, ", "))
-	return fmt.Sprintf("{%d, %s}", p.MIMEType, pMatch)
+	return fmt.Sprintf("[]*magicMatch{%s}", strings.Join(s, ", "))
 }
 
 type magicSliceType []*parsedMagic
@@ -225,8 +253,27 @@ func (p magicSliceType) Less(j, i int) bool {
 		return true
 	case p[i].MinPatternLen() > p[j].MinPatternLen():
 		return false
+	case p[i].TestNum() < p[j].TestNum():
+		return true
+	case p[i].TestNum() > p[j].TestNum():
+		return false
 	default:
-		return p[i].TestNum() < p[j].TestNum()
+		return lessTie(p[j].MIMEType, p[i].MIMEType, p[j].ancestors, p[i].ancestors)
+	}
+}
+
+// lessTie orders the signatures of MIME types a and b, which are
+// otherwise tied, keeping a subclass ahead of its superclass so that
+// the more specific type is detected, and else the later MIME type by
+// name first.
+func lessTie(a, b int, ancestorsA, ancestorsB []int) bool {
+	switch {
+	case containsInt(ancestorsA, b):
+		return true
+	case containsInt(ancestorsB, a):
+		return false
+	default:
+		return a > b
 	}
 }
 
@@ -284,6 +331,7 @@ func (p *parsedMatch) String() string {
 type parsedTreeMagic struct {
 	Priority, MIMEType int
 	TreeMatch          []*parsedTreeMatch
+	ancestors          []int
 }
 
 func (p *parsedTreeMagic) String() string {
@@ -292,7 +340,7 @@ func (p *parsedTreeMagic) String() string {
 		s = append(s, pp.String())
 	}
 	pMatch := fmt.Sprintf("[]treeMatch{%s}", strings.Join(s, ", "))
-	return fmt.Sprintf("{%d, %s}", p.MIMEType, pMatch)
+	return fmt.Sprintf("{%d, %d, %s}", p.MIMEType, p.Priority, pMatch)
 }
 
 func (p *parsedTreeMagic) TestNum() int {
@@ -313,8 +361,12 @@ func (p treeMagicSliceType) Less(j, i int) bool {
 		return true
 	case p[i].Priority > p[j].Priority:
 		return false
+	case p[i].TestNum() < p[j].TestNum():
+		return true
+	case p[i].TestNum() > p[j].TestNum():
+		return false
 	default:
-		return p[i].TestNum() < p[j].TestNum()
+		return lessTie(p[j].MIMEType, p[i].MIMEType, p[j].ancestors, p[i].ancestors)
 	}
 }
 
@@ -323,6 +375,7 @@ type parsedTreeMatch struct {
 	Type                            int
 	MatchCase, Executable, NonEmpty bool
 	TreeMatch                       []*parsedTreeMatch
+	mediaType                       int
 }
 
 func (p *parsedTreeMatch) TestNum() int {
@@ -342,10 +395,6 @@ func (p *parsedTreeMatch) String() string {
 		}
 		pMatch = fmt.Sprintf("[]treeMatch{%s}", strings.Join(s, ", "))
 	}
-	mType := -1
-	if p, ok := types[p.MIMEType]; ok {
-		mType = p.Lexicographic
-	}
 	var pType string
 	switch p.Type {
 	case 0:
@@ -357,7 +406,7 @@ func (p *parsedTreeMatch) String() string {
 	case 3:
 		pType = "linkType"
 	}
-	return fmt.Sprintf("{%q, %d, %s, %t, %t, %t, %s}", p.Path, mType, pType, p.MatchCase, p.Executable, p.NonEmpty, pMatch)
+	return fmt.Sprintf("{%q, %d, %s, %t, %t, %t, %s}", p.Path, p.mediaType, pType, p.MatchCase, p.Executable, p.NonEmpty, pMatch)
 }
 
 type parsedRootXML struct {
@@ -403,35 +452,55 @@ func (p identifierSliceType) Less(j, i int) bool {
 
 func (p identifierSliceType) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
 
-func (p identifierSliceType) GenerateMaps() (maxLen int) {
+// globTables are the glob tables the identifiers are generated
+// into: maps for the simple ones, and a slice for the rest.
+type globTables struct {
+	Suffix, Prefix, Text, CaseSensitiveSuffix, CaseSensitivePrefix, CaseSensitiveText map[string]weightedMIMESlice
+	Patterns                                                                          identifierSliceType
+	MaxLen                                                                            int
+}
+
+func (p identifierSliceType) GenerateMaps() *globTables {
+	t := &globTables{
+		Suffix:              make(map[string]weightedMIMESlice),
+		Prefix:              make(map[string]weightedMIMESlice),
+		Text:                make(map[string]weightedMIMESlice),
+		CaseSensitiveSuffix: make(map[string]weightedMIMESlice),
+		CaseSensitivePrefix: make(map[string]weightedMIMESlice),
+		CaseSensitiveText:   make(map[string]weightedMIMESlice),
+		Patterns:            make(identifierSliceType, 0),
+	}
+	add := func(m map[string]weightedMIMESlice, s string, w, mimeType int) {
+		m[s] = append(m[s], weightedMIME{w, mimeType})
+	}
 	for _, id := range p {
-		if l := id.length(); l > maxLen {
-			maxLen = l
+		if l := id.length(); l > t.MaxLen {
+			t.MaxLen = l
 		}
 		switch id := id.(type) {
 		case simpleSuffix:
 			if id.CaseSensitive {
-				csSuffixMap[string(id.suffix)] = append(csSuffixMap[string(id.suffix)], weightedMIME{id.W, id.MIMEType})
+				add(t.CaseSensitiveSuffix, string(id.suffix), id.W, id.MIMEType)
 			} else {
-				suffixMap[string(id.suffix)] = append(suffixMap[string(id.suffix)], weightedMIME{id.W, id.MIMEType})
+				add(t.Suffix, string(id.suffix), id.W, id.MIMEType)
 			}
 		case simplePrefix:
 			if id.CaseSensitive {
-				csPrefixMap[string(id.prefix)] = append(csPrefixMap[string(id.prefix)], weightedMIME{id.W, id.MIMEType})
+				add(t.CaseSensitivePrefix, string(id.prefix), id.W, id.MIMEType)
 			} else {
-				prefixMap[string(id.prefix)] = append(prefixMap[string(id.prefix)], weightedMIME{id.W, id.MIMEType})
+				add(t.Prefix, string(id.prefix), id.W, id.MIMEType)
 			}
 		case simpleText:
 			if id.CaseSensitive {
-				csTextMap[string(id.text)] = append(csTextMap[string(id.text)], weightedMIME{id.W, id.MIMEType})
+				add(t.CaseSensitiveText, string(id.text), id.W, id.MIMEType)
 			} else {
-				textMap[string(id.text)] = append(textMap[string(id.text)], weightedMIME{id.W, id.MIMEType})
+				add(t.Text, string(id.text), id.W, id.MIMEType)
 			}
 		default:
-			patternSlice = append(patternSlice, id)
+			t.Patterns = append(t.Patterns, id)
 		}
 	}
-	return
+	return t
 }
 
 type weightedMIME struct {
@@ -445,7 +514,8 @@ func (i weightedMIME) GoString() string {
 type weightedMIMESlice []weightedMIME
 
 func (i weightedMIMESlice) GoString() string {
-	return fmt.Sprintf("%#v", []weightedMIME(i))[len("[]main.weightedMIME"):]
+	s := fmt.Sprintf("%#v", []weightedMIME(i))
+	return s[strings.IndexByte(s, '{'):]
 }
 
 type prefix string
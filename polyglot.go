@@ -0,0 +1,161 @@
+package mimemagic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// zipTailLen is the longest a ZIP end of central directory
+// record can be: 22 bytes plus a comment of up to 65535 bytes.
+const zipTailLen = 22 + 0xffff
+
+var zipEOCD, pdfHeader = []byte("PK\x05\x06"), []byte("%PDF-")
+
+var htmlTags = [][]byte{[]byte("<html"), []byte("<script"), []byte("<body"), []byte("<iframe"), []byte("<svg")}
+
+// structureCheck is a check for file structures that magic
+// signatures can't express, because they either sit at the end
+// of the file or float at arbitrary offsets, yet are honoured
+// by the programs that open such files.
+type structureCheck struct {
+	mediaType string
+	// binary limits the check to files identified as a binary
+	// format first, without any markup signature matching, as
+	// markup of any kind is bound to contain what it looks for.
+	binary bool
+	match  func(head, tail []byte) bool
+}
+
+var structureChecks = []structureCheck{
+	// ZIP readers start from the central directory at the end.
+	{"application/zip", false, func(_, tail []byte) bool {
+		for i := bytes.LastIndex(tail, zipEOCD); i > -1; i = bytes.LastIndex(tail[:i], zipEOCD) {
+			if i+22 <= len(tail) && i+22+int(binary.LittleEndian.Uint16(tail[i+20:])) == len(tail) {
+				return true
+			}
+		}
+		return false
+	}},
+	// PDF readers accept the header anywhere in the first KiB.
+	{"application/pdf", false, func(head, _ []byte) bool {
+		return bytes.Contains(head[:min(len(head), 1024)], pdfHeader)
+	}},
+	// Browsers render markup wherever it appears, such as in the
+	// metadata of an image.
+	{"text/html", true, func(head, _ []byte) bool {
+		lower := bytes.ToLower(head)
+		for _, t := range htmlTags {
+			if bytes.Contains(lower, t) {
+				return true
+			}
+		}
+		return false
+	}},
+}
+
+// MatchPolyglot determines every MIME type the file in byte
+// slice form is valid as. Unlike MatchMagic, it doesn't stop at
+// the first matching signature, and also checks for structures
+// that are found at the end of the file or at arbitrary offsets,
+// such as a ZIP central directory. MIME types that are related
+// to one another as a subclass are reported once, as the most
+// specific one, so a result with more than one element means
+// the file is a polyglot. Textual signatures that match after a
+// binary format has been identified are ignored, as are markup
+// signatures after markup, and HTML embedded in anything but a
+// binary format isn't looked for. A file that isn't identified
+// at all yields a nil slice.
+func MatchPolyglot(data []byte) []MediaType {
+	return polyglot(data, data[len(data)-min(len(data), zipTailLen):])
+}
+
+// MatchPolyglotReaderAt is an io.ReaderAt wrapper for
+// MatchPolyglot, for a file of the given size. Only the
+// beginning and the end of the file are read, so it's suitable
+// for files too large to keep in memory.
+func MatchPolyglotReaderAt(r io.ReaderAt, size int64) ([]MediaType, error) {
+	head := make([]byte, min64(size, int64(magicMaxLen)))
+	if _, err := r.ReadAt(head, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+	if size <= int64(magicMaxLen) {
+		return MatchPolyglot(head), nil
+	}
+	tail := make([]byte, min64(size, zipTailLen))
+	if _, err := r.ReadAt(tail, size-int64(len(tail))); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return polyglot(head, tail), nil
+}
+
+func polyglot(head, tail []byte) []MediaType {
+	if len(head) > magicMaxLen {
+		head = head[:magicMaxLen]
+	}
+	var matches []int
+	if len(head) > 0 {
+		binary, binaryFirst, markup := false, false, false
+		for _, m := range magicSignatures {
+			if !m.match(head) {
+				continue
+			}
+			// Textual signatures, such as a leading '%' for TeX,
+			// are too weak to be of any significance once a
+			// binary format has been identified, and so are
+			// markup signatures once markup has been, as XHTML
+			// and SVG are bound to contain HTML tags.
+			text := equalOrSuperClass([]int{m.mediaType}, plainText) > -1
+			t := mediaTypes[m.mediaType]
+			isMarkup := t.Is("text/html") || t.Is("application/xml")
+			if text && binary || isMarkup && markup {
+				continue
+			}
+			if matches == nil {
+				binaryFirst = !text
+			}
+			binary = binary || !text
+			markup = markup || isMarkup
+			matches = appendUnrelated(matches, m.mediaType)
+		}
+		for _, s := range structureChecks {
+			if s.binary && (!binaryFirst || markup) {
+				continue
+			}
+			if i := lookup(s.mediaType); i > -1 && s.match(head, tail) {
+				matches = appendUnrelated(matches, i)
+			}
+		}
+	}
+	if matches == nil {
+		return nil
+	}
+	results := make([]MediaType, len(matches))
+	for i, m := range matches {
+		results[i] = mediaTypes[m]
+	}
+	return results
+}
+
+// appendUnrelated appends m to matches unless it's already
+// there or a subclass of it is, removing any of its superclasses.
+func appendUnrelated(matches []int, m int) []int {
+	if equalOrSuperClass(matches, m) > -1 {
+		return matches
+	}
+	n := 0
+	for _, mm := range matches {
+		if equalOrSuperClass([]int{m}, mm) < 0 {
+			matches[n] = mm
+			n++
+		}
+	}
+	return append(matches[:n], m)
+}
+
+func min64(i, j int64) int64 {
+	if i < j {
+		return i
+	}
+	return j
+}
@@ -0,0 +1,81 @@
+package mimemagic
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+)
+
+func zipArchive(t testing.TB, files ...string) []byte {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for _, f := range files {
+		fw, err := w.Create(f)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		fw.Write([]byte("content of " + f))
+	}
+	w.SetComment("polyglot")
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestMatchPolyglot(t *testing.T) {
+	archive := zipArchive(t, "META-INF/MANIFEST.MF", "Main.class")
+	gif := []byte("GIF89a\x01\x00\x01\x00\x80\x00\x00\xff\xff\xff\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02D\x01\x00;")
+	padding := make([]byte, 2*magicMaxLen)
+	tests := []struct {
+		name string
+		data []byte
+		want []string
+	}{
+		{"empty", nil, nil},
+		{"unknown", []byte("\x00\x01\x02\x03"), nil},
+		{"png", pngHeader, []string{"image/png"}},
+		{"zip", archive, []string{"application/zip"}},
+		{"epub", []byte(epubHeader + "PK\x05\x06\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"),
+			[]string{"application/epub+zip"}},
+		{"gif+jar", append(append([]byte{}, gif...), archive...), []string{"image/gif", "application/zip"}},
+		{"gif+padding+jar", bytes.Join([][]byte{gif, padding, archive}, nil), []string{"image/gif", "application/zip"}},
+		{"html+png", append(append([]byte{}, pngHeader...), "tEXt<script>alert(1)</script>"...),
+			[]string{"image/png", "text/html"}},
+		{"pdf+zip", append([]byte("\x00\x00%PDF-1.4\n"), archive...), []string{"application/pdf", "application/zip"}},
+		{"pdf", []byte("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n"), []string{"application/pdf"}},
+		{"svg", []byte(`<svg xmlns="http://www.w3.org/2000/svg"><rect/></svg>`), []string{"image/svg+xml"}},
+		{"svg script", []byte(`<?xml version="1.0"?>` + "\n" +
+			`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), []string{"image/svg+xml"}},
+		{"xhtml", []byte(`<?xml version="1.0"?>` + "\n" + `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Strict//EN" ` +
+			`"http://www.w3.org/TR/xhtml1/DTD/xhtml1-strict.dtd">` + "\n" + `<html xmlns="http://www.w3.org/1999/xhtml"><body></body></html>`),
+			[]string{"application/xhtml+xml"}},
+		{"broken zip", append(append([]byte{}, gif...), archive[:len(archive)-1]...), []string{"image/gif"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			check := func(name string, got []MediaType) {
+				var names []string
+				for _, m := range got {
+					names = append(names, m.MediaType())
+				}
+				if len(names) != len(test.want) {
+					t.Errorf("%s() = %v, want %v", name, names, test.want)
+					return
+				}
+				for i := range names {
+					if names[i] != test.want[i] {
+						t.Errorf("%s() = %v, want %v", name, names, test.want)
+						return
+					}
+				}
+			}
+			check("MatchPolyglot", MatchPolyglot(test.data))
+			got, err := MatchPolyglotReaderAt(bytes.NewReader(test.data), int64(len(test.data)))
+			if err != nil {
+				t.Errorf("MatchPolyglotReaderAt() error = %v", err)
+			}
+			check("MatchPolyglotReaderAt", got)
+		})
+	}
+}
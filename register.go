@@ -0,0 +1,358 @@
+package mimemagic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Tables is a MIME type database the matching engine can be
+// switched to with Register, such as the one the parser generates
+// into a package of its own with -package. The other tables refer
+// to the MIME types by their index in MediaTypes.
+type Tables interface {
+	// MediaTypes returns the MIME types of the database.
+	MediaTypes() []MediaType
+	// Globs returns the file name patterns of the database.
+	Globs() []TableGlob
+	// Magic returns the magic signatures of the database, in the
+	// order they're checked in.
+	Magic() []TableMagic
+	// XMLRoots returns the root elements of the XML documents of
+	// the MIME types of the database.
+	XMLRoots() []TableXMLRoot
+	// TreeMagic returns the tree magic signatures of the
+	// database, in the order they're checked in.
+	TreeMagic() []TableTreeMagic
+}
+
+// TableGlob is a file name pattern of the MIME type at index
+// MediaType of a database.
+type TableGlob struct {
+	MediaType int
+	GlobPattern
+}
+
+// TableMagic is a magic signature of the MIME type at index
+// MediaType of a database.
+type TableMagic struct {
+	MediaType int
+	MagicSignature
+}
+
+// TableXMLRoot is the root element of the XML documents of the
+// MIME type at index MediaType of a database.
+type TableXMLRoot struct {
+	MediaType int
+	XMLRoot
+}
+
+// TableTreeMagic is a tree magic signature of the MIME type at
+// index MediaType of a database.
+type TableTreeMagic struct {
+	MediaType int
+	TreeMagicSignature
+}
+
+var errInvalidGlob = errors.New("invalid glob pattern")
+
+// Register switches the matching engine over to the database t,
+// which must hold the application/octet-stream,
+// application/x-zerosize, text/plain, application/xml and
+// inode/directory MIME types. Special files whose inode type t
+// lacks match application/octet-stream. It isn't safe to call
+// concurrently with matching, so it's best called from an init
+// function.
+func Register(t Tables) error {
+	types := append([]MediaType(nil), t.MediaTypes()...)
+	index := make(map[string]int, len(types))
+	for i := range types {
+		for _, a := range types[i].Alias {
+			index[a] = i
+		}
+	}
+	for i := range types {
+		index[types[i].MediaType()] = i
+	}
+	for i := range types {
+		types[i].subClassOf = nil
+	parents:
+		for _, p := range types[i].SubClassOf {
+			j, ok := index[p]
+			if !ok {
+				continue
+			}
+			for _, k := range types[i].subClassOf {
+				if k == j {
+					continue parents
+				}
+			}
+			types[i].subClassOf = append(types[i].subClassOf, j)
+		}
+	}
+	var special [5]int
+	for i, name := range [...]string{"application/octet-stream", "application/x-zerosize", "text/plain", "inode/directory", "application/xml"} {
+		j, ok := index[name]
+		if !ok {
+			return fmt.Errorf("mimemagic: missing MIME type %s", name)
+		}
+		special[i] = j
+	}
+	valid := func(i int) error {
+		if i < 0 || i >= len(types) {
+			return fmt.Errorf("mimemagic: invalid MIME type index %d", i)
+		}
+		return nil
+	}
+
+	var compiled []glob
+	for _, g := range t.Globs() {
+		if err := valid(g.MediaType); err != nil {
+			return err
+		}
+		c, err := compileGlob(g)
+		if err != nil {
+			return fmt.Errorf("mimemagic: %s: %v", g.Pattern, err)
+		}
+		compiled = append(compiled, c)
+	}
+	sort.SliceStable(compiled, func(i, j int) bool {
+		a, b := compiled[i], compiled[j]
+		switch {
+		case a.mediaType().weight != b.mediaType().weight:
+			return a.mediaType().weight > b.mediaType().weight
+		case a.len() != b.len():
+			return a.len() > b.len()
+		case a.isCaseSensitive() != b.isCaseSensitive():
+			return a.isCaseSensitive()
+		default:
+			return a.mediaType().mimeType < b.mediaType().mimeType
+		}
+	})
+	tables := [6]map[string][]simpleGlob{}
+	for i := range tables {
+		tables[i] = make(map[string][]simpleGlob)
+	}
+	var patterns []glob
+	maxGlobLen := 0
+	for _, g := range compiled {
+		if g.len() > maxGlobLen {
+			maxGlobLen = g.len()
+		}
+		var p pattern
+		table := 0
+		switch g := g.(type) {
+		case textPattern:
+			p = g.pattern
+		case suffixPattern:
+			p, table = g.pattern, 2
+		case prefixPattern:
+			p, table = g.pattern, 4
+		}
+		if g.isCaseSensitive() {
+			table++
+		}
+		key, ok := simpleGlobKey(p)
+		if !ok {
+			patterns = append(patterns, g)
+			continue
+		}
+		tables[table][key] = append(tables[table][key], g.mediaType())
+	}
+
+	var signatures []magic
+	maxMagicLen := 0
+	for _, m := range t.Magic() {
+		if err := valid(m.MediaType); err != nil {
+			return err
+		}
+		matchers, l := magicMatchers(m.Rules)
+		if l > maxMagicLen {
+			maxMagicLen = l
+		}
+		signatures = append(signatures, magic{m.MediaType, m.Priority, matchers})
+	}
+
+	var roots []namespace
+	for _, r := range t.XMLRoots() {
+		if err := valid(r.MediaType); err != nil {
+			return err
+		}
+		roots = append(roots, namespace{r.NamespaceURI, r.LocalName, r.MediaType})
+	}
+
+	var treeSignatures []treeMagic
+	for _, s := range t.TreeMagic() {
+		if err := valid(s.MediaType); err != nil {
+			return err
+		}
+		matchers, err := treeMatchers(s.Rules, index)
+		if err != nil {
+			return err
+		}
+		treeSignatures = append(treeSignatures, treeMagic{s.MediaType, s.Priority, matchers})
+	}
+
+	mediaTypes = types
+	unknownType, emptyDocument, plainText, unknownDirectory, unknownXML = special[0], special[1], special[2], special[3], special[4]
+	text, textCS, suffixes, suffixesCS, prefixes, prefixesCS = tables[0], tables[1], tables[2], tables[3], tables[4], tables[5]
+	globs, globMaxLen = patterns, maxGlobLen
+	magicSignatures, magicMaxLen = signatures, maxMagicLen
+	namespaces = roots
+	treeMagicSignatures = treeSignatures
+	resetIndices()
+	return nil
+}
+
+// resetIndices drops the indices built from the tables, so that
+// they're rebuilt from new ones on demand.
+func resetIndices() {
+	nameIndex, nameIndexOnce = nil, sync.Once{}
+	httpContentTypeIndex, httpContentTypeIndexOnce = nil, sync.Once{}
+	scanIndex, scanIndexOnce = [256][]scanSignature{}, sync.Once{}
+}
+
+// compileGlob compiles the file name pattern of g, which can hold
+// a leading or a trailing asterisk, and bracket expressions.
+func compileGlob(g TableGlob) (glob, error) {
+	s := g.Pattern
+	if !g.CaseSensitive {
+		s = strings.ToLower(s)
+	}
+	prefix, suffix := false, false
+	switch strings.IndexByte(s, '*') {
+	case -1:
+	case 0:
+		suffix, s = true, s[1:]
+	case len(s) - 1:
+		prefix, s = true, s[:len(s)-1]
+	default:
+		return nil, errInvalidGlob
+	}
+	var p pattern
+	for s != "" {
+		i := strings.IndexByte(s, '[')
+		if i < 0 {
+			i = len(s)
+		}
+		if i > 0 {
+			if strings.IndexByte(s[:i], ']') >= 0 {
+				return nil, errInvalidGlob
+			}
+			p.matchers = append(p.matchers, value(s[:i]))
+			p.length += i
+			s = s[i:]
+			continue
+		}
+		j := strings.IndexByte(s, ']')
+		if j < 0 || strings.IndexByte(s[1:j], '[') >= 0 {
+			return nil, errInvalidGlob
+		}
+		m, err := compileBracket(s[1:j])
+		if err != nil {
+			return nil, err
+		}
+		p.matchers = append(p.matchers, m.(matcher))
+		p.length++
+		s = s[j+1:]
+	}
+	switch {
+	case suffix:
+		return suffixPattern{p, g.CaseSensitive, g.MediaType, g.Weight}, nil
+	case prefix:
+		return prefixPattern{p, g.CaseSensitive, g.MediaType, g.Weight}, nil
+	default:
+		return textPattern{p, g.CaseSensitive, g.MediaType, g.Weight}, nil
+	}
+}
+
+// compileBracket compiles the bytes and byte ranges within the
+// brackets of a bracket expression.
+func compileBracket(s string) (byteMatcher, error) {
+	if strings.IndexByte(s, '-') < 0 {
+		return list(s), nil
+	}
+	var a any
+	for len(s) > 0 {
+		switch dash := strings.IndexByte(s, '-'); {
+		case dash == 0, dash == len(s)-1, dash == 1 && s[0] > s[2]:
+			return nil, errInvalidGlob
+		case dash == 1:
+			a, s = append(a, byteRange{s[0], s[2]}), s[3:]
+		case dash < 0:
+			a, s = append(a, list(s)), ""
+		default:
+			a, s = append(a, list(s[:dash-1])), s[dash-1:]
+		}
+	}
+	if len(a) == 1 {
+		return a[0], nil
+	}
+	return a, nil
+}
+
+// simpleGlobKey returns the literal a compiled pattern matches, if
+// it's made up of one.
+func simpleGlobKey(p pattern) (string, bool) {
+	switch len(p.matchers) {
+	case 0:
+		return "", true
+	case 1:
+		v, ok := p.matchers[0].(value)
+		return string(v), ok
+	}
+	return "", false
+}
+
+// magicMatchers turns magic rules into matchers, along with the
+// number of bytes they need to be checked against.
+func magicMatchers(rules []MagicRule) ([]*magicMatch, int) {
+	var matchers []*magicMatch
+	max := 0
+	for _, r := range rules {
+		next, l := magicMatchers(r.Next)
+		if n := r.Offset + r.Range + len(r.Value); n > l {
+			l = n
+		}
+		if l > max {
+			max = l
+		}
+		matchers = append(matchers, &magicMatch{r.Offset, r.Range, r.Value, r.Mask, next})
+	}
+	return matchers, max
+}
+
+// treeMatchers turns tree magic rules into matchers, looking their
+// MIME types up in index.
+func treeMatchers(rules []TreeMagicRule, index map[string]int) ([]treeMatch, error) {
+	var matchers []treeMatch
+	for _, r := range rules {
+		m := treeMatch{path: r.Path, mediaType: -1, matchCase: r.MatchCase, executable: r.Executable, nonEmpty: r.NonEmpty}
+		if r.MediaType != "" {
+			if i, ok := index[r.MediaType]; ok {
+				m.mediaType = i
+			}
+		}
+		switch r.Type {
+		case objectTypes[anyType]:
+			m.objectType = anyType
+		case objectTypes[fileType]:
+			m.objectType = fileType
+		case objectTypes[directoryType]:
+			m.objectType = directoryType
+		case objectTypes[linkType]:
+			m.objectType = linkType
+		default:
+			return nil, fmt.Errorf("mimemagic: invalid tree magic rule type %s", r.Type)
+		}
+		next, err := treeMatchers(r.Next, index)
+		if err != nil {
+			return nil, err
+		}
+		m.next = next
+		matchers = append(matchers, m)
+	}
+	return matchers, nil
+}
@@ -0,0 +1,133 @@
+package mimemagic
+
+import (
+	"bytes"
+	"io/fs"
+	"reflect"
+	"testing"
+)
+
+type testTables struct {
+	types     []MediaType
+	globs     []TableGlob
+	magic     []TableMagic
+	roots     []TableXMLRoot
+	treeMagic []TableTreeMagic
+}
+
+func (t testTables) MediaTypes() []MediaType     { return t.types }
+func (t testTables) Globs() []TableGlob          { return t.globs }
+func (t testTables) Magic() []TableMagic         { return t.magic }
+func (t testTables) XMLRoots() []TableXMLRoot    { return t.roots }
+func (t testTables) TreeMagic() []TableTreeMagic { return t.treeMagic }
+
+// restoreTables returns a function that restores the tables as
+// they are now.
+func restoreTables() func() {
+	types, special := mediaTypes, [...]int{unknownType, emptyDocument, plainText, unknownDirectory, unknownXML}
+	t, tCS, s, sCS, p, pCS := text, textCS, suffixes, suffixesCS, prefixes, prefixesCS
+	g, gLen, m, mLen, n, tm := globs, globMaxLen, magicSignatures, magicMaxLen, namespaces, treeMagicSignatures
+	return func() {
+		mediaTypes = types
+		unknownType, emptyDocument, plainText, unknownDirectory, unknownXML = special[0], special[1], special[2], special[3], special[4]
+		text, textCS, suffixes, suffixesCS, prefixes, prefixesCS = t, tCS, s, sCS, p, pCS
+		globs, globMaxLen, magicSignatures, magicMaxLen, namespaces, treeMagicSignatures = g, gLen, m, mLen, n, tm
+		resetIndices()
+	}
+}
+
+func TestRegister(t *testing.T) {
+	defer restoreTables()()
+	// Pool a buffer of the size of the built-in signatures, which
+	// the longer ones registered below don't fit in.
+	if _, err := MatchReader(bytes.NewReader(nil), ""); err != nil {
+		t.Fatalf("MatchReader() error = %v", err)
+	}
+	long := magicMaxLen + 1000
+	tables := testTables{
+		types: []MediaType{
+			{Media: "application", Subtype: "octet-stream"},
+			{Media: "application", Subtype: "x-zerosize"},
+			{Media: "text", Subtype: "plain"},
+			{Media: "application", Subtype: "xml", SubClassOf: []string{"text/plain"}},
+			{Media: "inode", Subtype: "directory"},
+			{Media: "text", Subtype: "x-ours", Alias: []string{"text/x-alias"}, SubClassOf: []string{"text/plain", "text/plain"}},
+			{Media: "x-content", Subtype: "x-ours"},
+		},
+		globs: []TableGlob{
+			{MediaType: 5, GlobPattern: GlobPattern{Pattern: "*.OURS", Weight: 50}},
+			{MediaType: 5, GlobPattern: GlobPattern{Pattern: "ours[0-9]*", Weight: 50}},
+			{MediaType: 3, GlobPattern: GlobPattern{Pattern: "*.xml", Weight: 50}},
+		},
+		magic: []TableMagic{
+			{MediaType: 5, MagicSignature: MagicSignature{Rules: []MagicRule{{Offset: 2, Value: []byte("ours")}}}},
+			{MediaType: 5, MagicSignature: MagicSignature{Rules: []MagicRule{{Offset: long, Value: []byte("long")}}}},
+		},
+		roots: []TableXMLRoot{
+			{MediaType: 5, XMLRoot: XMLRoot{LocalName: "ours"}},
+		},
+		treeMagic: []TableTreeMagic{
+			{MediaType: 6, TreeMagicSignature: TreeMagicSignature{Priority: 50, Rules: []TreeMagicRule{{Path: "ours", Type: "file"}}}},
+		},
+	}
+	if err := Register(tables); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	matchReader := func(data []byte) string {
+		m, err := MatchReader(bytes.NewReader(data), "")
+		if err != nil {
+			t.Errorf("MatchReader() error = %v", err)
+		}
+		return m.MediaType()
+	}
+	matchPath := func(path string) string {
+		m, err := MatchPath(path, false)
+		if err != nil {
+			t.Errorf("MatchPath() error = %v", err)
+		}
+		return m.MediaType()
+	}
+	for _, tt := range []struct {
+		name, got, want string
+	}{
+		{"MatchGlob", MatchGlob("file.ours").MediaType(), "text/x-ours"},
+		{"MatchGlob", MatchGlob("ours1.txt").MediaType(), "text/x-ours"},
+		{"MatchGlob", MatchGlob("file.png").MediaType(), "application/octet-stream"},
+		{"MatchMagic", MatchMagic([]byte("..ours")).MediaType(), "text/x-ours"},
+		{"MatchMagic", MatchMagic(nil).MediaType(), "application/x-zerosize"},
+		{"MatchXML", MatchXML([]byte("<ours/>")).MediaType(), "text/x-ours"},
+		{"MatchReader", matchReader(append(make([]byte, long), "long"...)), "text/x-ours"},
+		{"MatchPath", matchPath("/"), "inode/directory"},
+		{"inodeType", mediaTypes[inodeType(fs.ModeNamedPipe)].MediaType(), "application/octet-stream"},
+		{"Lookup", lookupType(t, "text/x-alias").MediaType(), "text/x-ours"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if m := lookupType(t, "text/x-ours"); !reflect.DeepEqual(m.subClassOf, []int{2}) {
+		t.Errorf("subClassOf = %v, want [2]", m.subClassOf)
+	}
+	if got := lookupType(t, "text/x-ours").Globs(); !reflect.DeepEqual(got, []GlobPattern{{"*.ours", 50, false}, {"ours[0-9]*", 50, false}}) {
+		t.Errorf("Globs() = %v", got)
+	}
+	if got, want := lookupType(t, "x-content/x-ours").TreeMagic(), []TreeMagicSignature{tables.treeMagic[0].TreeMagicSignature}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TreeMagic() = %v", got)
+	}
+
+	broken := []testTables{
+		{types: tables.types[1:]},
+		{types: tables.types, globs: []TableGlob{{MediaType: 5, GlobPattern: GlobPattern{Pattern: "a*b", Weight: 50}}}},
+		{types: tables.types, globs: []TableGlob{{MediaType: 5, GlobPattern: GlobPattern{Pattern: "*.[a-", Weight: 50}}}},
+		{types: tables.types, magic: []TableMagic{{MediaType: 7}}},
+		{types: tables.types, treeMagic: []TableTreeMagic{{MediaType: 6, TreeMagicSignature: TreeMagicSignature{Rules: []TreeMagicRule{{Path: "ours", Type: "socket"}}}}}},
+	}
+	for i, b := range broken {
+		if err := Register(b); err == nil {
+			t.Errorf("Register() of broken tables %d error = nil", i)
+		}
+	}
+	if got := MatchGlob("file.ours").MediaType(); got != "text/x-ours" {
+		t.Errorf("MatchGlob() after a failed Register() = %v, want text/x-ours", got)
+	}
+}
@@ -0,0 +1,216 @@
+package mimemagic
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"math"
+	"sync"
+)
+
+const scanChunkLen = 1 << 20
+
+// ScanOptions configures Scan.
+// MinStrength is the least number of bytes a signature has to
+// compare for it to be considered, as short signatures match
+// all over arbitrary data. Non-positive values default to 4.
+// Depth is the number of levels of nested containers (gzip
+// streams and zip archives) to descend into, with 0 disabling
+// recursion. MaxSize caps the number of decompressed bytes
+// examined per container, defaulting to 64 MiB.
+type ScanOptions struct {
+	MinStrength, Depth int
+	MaxSize            int64
+}
+
+// ScanResult is an embedded file found by Scan, at Offset bytes
+// from the start of its container. Parent is the container it
+// was found in, or nil at the top level, and Name is the name
+// of the zip archive member or gzip stream it was found in.
+type ScanResult struct {
+	Offset    int64
+	MediaType MediaType
+	Parent    *ScanResult
+	Name      string
+}
+
+// ErrStopScan can be returned by the Scan callback to stop
+// scanning without Scan returning an error.
+var ErrStopScan = errors.New("stop scan")
+
+type scanSignature struct {
+	mediaType, strength int
+	// second and secondMask are used to rule out most
+	// candidates before evaluating the whole tree.
+	second, secondMask byte
+	matcher            *magicMatch
+	validate           func([]byte) bool
+}
+
+// scanValidators check the headers of formats whose signatures
+// are too short to be told apart from noise on their own. A
+// signature with a validator is never too weak to be reported.
+var scanValidators = map[string]func([]byte) bool{
+	// Deflate compression, and no reserved flags.
+	"application/gzip": func(b []byte) bool { return len(b) >= 10 && b[2] == 8 && b[3]&0xe0 == 0 },
+}
+
+var (
+	scanIndex     [256][]scanSignature
+	scanIndexOnce sync.Once
+)
+
+// Scan walks the size bytes of r, and calls fn for every offset
+// where a magic signature anchored at the beginning of the file
+// matches, such as a gzip stream in the middle of a firmware
+// image, or a JPEG in a disk image. Results are reported in
+// order of their offset, with only the best matching MIME type
+// reported for each offset. If fn returns an error, scanning
+// stops and the error is returned, unless it's ErrStopScan.
+func Scan(r io.ReaderAt, size int64, opts ScanOptions, fn func(ScanResult) error) error {
+	if opts.MinStrength <= 0 {
+		opts.MinStrength = 4
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = 64 << 20
+	}
+	scanIndexOnce.Do(buildScanIndex)
+	err := scan(r, size, opts, nil, "", opts.Depth, fn)
+	if err == ErrStopScan {
+		return nil
+	}
+	return err
+}
+
+func buildScanIndex() {
+	for _, m := range magicSignatures {
+		for _, mm := range m.matchers {
+			if mm.start != 0 || mm.length != 0 || len(mm.pattern) == 0 {
+				continue
+			}
+			s := scanSignature{mediaType: m.mediaType, strength: mm.strength(), matcher: mm}
+			if len(mm.pattern) > 1 {
+				s.second, s.secondMask = mm.pattern[1], 0xff
+				if mm.mask != nil {
+					s.secondMask = mm.mask[1]
+				}
+			}
+			if v, ok := scanValidators[mediaTypes[m.mediaType].MediaType()]; ok {
+				s.strength, s.validate = math.MaxInt32, v
+			}
+			if mm.mask == nil || mm.mask[0] == 0xff {
+				scanIndex[mm.pattern[0]] = append(scanIndex[mm.pattern[0]], s)
+				continue
+			}
+			for b := range scanIndex {
+				if byte(b)&mm.mask[0] == mm.pattern[0] {
+					scanIndex[b] = append(scanIndex[b], s)
+				}
+			}
+		}
+	}
+}
+
+// strength is the least number of bytes compared on a path
+// through the tree that results in a match.
+func (m *magicMatch) strength() int {
+	n := 0
+	for _, mm := range m.next {
+		if s := mm.strength(); n == 0 || s < n {
+			n = s
+		}
+	}
+	return len(m.pattern) + n
+}
+
+func scan(r io.ReaderAt, size int64, opts ScanOptions, parent *ScanResult, name string, depth int, fn func(ScanResult) error) error {
+	buf := make([]byte, scanChunkLen+magicMaxLen)
+	// The local file headers of a zip archive all match its
+	// signature, but it only needs to be descended into once.
+	var containerEnd int64
+	for pos := int64(0); pos < size; pos += scanChunkLen {
+		n, err := r.ReadAt(buf[:min64(int64(len(buf)), size-pos)], pos)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		data := buf[:n]
+		for i := 0; i < scanChunkLen && i < n; i++ {
+			window := data[i:min(n, i+magicMaxLen)]
+			var second byte
+			if len(window) > 1 {
+				second = window[1]
+			}
+			for _, s := range scanIndex[window[0]] {
+				if second&s.secondMask != s.second || s.strength < opts.MinStrength ||
+					!s.matcher.match(window) || s.validate != nil && !s.validate(window) {
+					continue
+				}
+				res := ScanResult{pos + int64(i), mediaTypes[s.mediaType], parent, name}
+				if err := fn(res); err != nil {
+					return err
+				}
+				if depth > 0 && res.Offset >= containerEnd {
+					end, err := scanContainer(r, size, opts, &res, depth-1, fn)
+					if err != nil {
+						return err
+					}
+					if end > containerEnd {
+						containerEnd = end
+					}
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// scanContainer descends into gzip streams and zip archives,
+// and returns the offset the container ends at, if known.
+// Containers that turn out to be corrupt are skipped silently,
+// since false positives are to be expected in arbitrary data.
+func scanContainer(r io.ReaderAt, size int64, opts ScanOptions, res *ScanResult, depth int, fn func(ScanResult) error) (int64, error) {
+	section := io.NewSectionReader(r, res.Offset, size-res.Offset)
+	switch {
+	case res.MediaType.Is("application/gzip"):
+		zr, err := gzip.NewReader(section)
+		if err != nil {
+			return 0, nil
+		}
+		zr.Multistream(false)
+		data, _ := readAll(zr, opts.MaxSize)
+		return 0, scan(bytes.NewReader(data), int64(len(data)), opts, res, zr.Name, depth, fn)
+	case res.MediaType.Is("application/zip"):
+		zr, err := zip.NewReader(section, section.Size())
+		if err != nil {
+			return 0, nil
+		}
+		end := res.Offset
+		for _, f := range zr.File {
+			if off, err := f.DataOffset(); err == nil && res.Offset+off+int64(f.CompressedSize64) > end {
+				end = res.Offset + off + int64(f.CompressedSize64)
+			}
+			rc, err := f.Open()
+			if err != nil {
+				continue
+			}
+			data, _ := readAll(rc, opts.MaxSize)
+			rc.Close()
+			if err = scan(bytes.NewReader(data), int64(len(data)), opts, res, f.Name, depth, fn); err != nil {
+				return 0, err
+			}
+		}
+		return end, nil
+	}
+	return 0, nil
+}
+
+// readAll reads up to limit bytes from r, returning whatever
+// was read before any error, as corrupt streams are common.
+func readAll(r io.Reader, limit int64) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	_, err := io.Copy(buf, io.LimitReader(r, limit))
+	return buf.Bytes(), err
+}
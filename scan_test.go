@@ -0,0 +1,128 @@
+package mimemagic
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func gzipStream(t testing.TB, name string, data []byte) []byte {
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	w.Name = name
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func scanResults(t *testing.T, data []byte, opts ScanOptions) []string {
+	var got []string
+	err := Scan(bytes.NewReader(data), int64(len(data)), opts, func(r ScanResult) error {
+		s := fmt.Sprintf("%d %s", r.Offset, r.MediaType.MediaType())
+		for p := &r; p.Parent != nil; p = p.Parent {
+			s = fmt.Sprintf("%d/%s:", p.Parent.Offset, p.Name) + s
+		}
+		got = append(got, s)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	return got
+}
+
+func TestScan(t *testing.T) {
+	noise := bytes.Repeat([]byte{0xaa}, 1000)
+	gz := gzipStream(t, "inner.png", append(append([]byte{}, noise[:10]...), pngHeader...))
+	archive := zipArchive(t, "a.txt", "b.txt")
+	nested := new(bytes.Buffer)
+	zw := zip.NewWriter(nested)
+	fw, _ := zw.CreateHeader(&zip.FileHeader{Name: "image.png", Method: zip.Store})
+	fw.Write(append(append([]byte{}, noise[:5]...), pngHeader...))
+	zw.Close()
+	image := bytes.Join([][]byte{noise, pngHeader, noise, gz, noise, archive}, nil)
+	pngOffset, gzOffset := len(noise), 2*len(noise)+len(pngHeader)
+	zipOffset := gzOffset + len(gz) + len(noise)
+	secondOffset := zipOffset + bytes.Index(archive[1:], []byte("PK\x03\x04")) + 1
+	tests := []struct {
+		name string
+		data []byte
+		opts ScanOptions
+		want []string
+	}{
+		{"empty", nil, ScanOptions{}, nil},
+		{"flat", image, ScanOptions{}, []string{
+			fmt.Sprintf("%d image/png", pngOffset),
+			fmt.Sprintf("%d application/gzip", gzOffset),
+			fmt.Sprintf("%d application/zip", zipOffset),
+			fmt.Sprintf("%d application/zip", secondOffset),
+		}},
+		{"recursive", image, ScanOptions{Depth: 1}, []string{
+			fmt.Sprintf("%d image/png", pngOffset),
+			fmt.Sprintf("%d application/gzip", gzOffset),
+			fmt.Sprintf("%d/inner.png:10 image/png", gzOffset),
+			fmt.Sprintf("%d application/zip", zipOffset),
+			fmt.Sprintf("%d application/zip", secondOffset),
+		}},
+		{"recursive zip", nested.Bytes(), ScanOptions{Depth: 1}, []string{
+			"0 application/zip",
+			"0/image.png:5 image/png",
+		}},
+		{"depth", bytes.Join([][]byte{noise, gzipStream(t, "", nested.Bytes())}, nil), ScanOptions{Depth: 2}, []string{
+			fmt.Sprintf("%d application/gzip", len(noise)),
+			fmt.Sprintf("%d/:0 application/zip", len(noise)),
+			fmt.Sprintf("%d/:0/image.png:5 image/png", len(noise)),
+		}},
+		{"chunk boundary", append(make([]byte, scanChunkLen-4), pngHeader...), ScanOptions{}, []string{
+			fmt.Sprintf("%d image/png", scanChunkLen-4),
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Compressed data and zip headers may contain false
+			// positives, so only check the results appear in order.
+			got, i := scanResults(t, test.data, test.opts), 0
+			for _, g := range got {
+				if i < len(test.want) && g == test.want[i] {
+					i++
+				}
+			}
+			if i != len(test.want) {
+				t.Errorf("Scan() = %v, want %v", got, test.want)
+			}
+		})
+	}
+	t.Run("stop", func(t *testing.T) {
+		n := 0
+		err := Scan(bytes.NewReader(image), int64(len(image)), ScanOptions{}, func(ScanResult) error {
+			n++
+			return ErrStopScan
+		})
+		if err != nil || n != 1 {
+			t.Errorf("Scan() error = %v, calls = %d, want nil, 1", err, n)
+		}
+		wantErr := errors.New("callback error")
+		err = Scan(bytes.NewReader(image), int64(len(image)), ScanOptions{}, func(ScanResult) error {
+			return wantErr
+		})
+		if err != wantErr {
+			t.Errorf("Scan() error = %v, want %v", err, wantErr)
+		}
+	})
+}
+
+func BenchmarkScan(b *testing.B) {
+	data := make([]byte, 8<<20)
+	for i := range data {
+		data[i] = byte(i * 7919 >> 3)
+	}
+	b.SetBytes(int64(len(data)))
+	for n := 0; n < b.N; n++ {
+		Scan(bytes.NewReader(data), int64(len(data)), ScanOptions{}, func(ScanResult) error { return nil })
+	}
+}
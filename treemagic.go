@@ -1,14 +1,19 @@
 package mimemagic
 
 import (
+	"errors"
+	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
 type treeMagic struct {
-	mediaType int
-	matchers  []treeMatch
+	mediaType, priority int
+	matchers            []treeMatch
 }
 
 type treeMatch struct {
@@ -34,66 +39,266 @@ const (
 // Return inode/directory MediaType in the case of a negative
 // identification for a directory, and application/octet-stream
 // in the case of a file.
+// Only the paths referenced by the signatures are examined, so
+// the size of the volume doesn't matter. If the file is an ISO
+// 9660 or UDF disc image, its contents are examined instead of
+// its directory.
 func MatchTreeMagic(path string) (MediaType, error) {
-	m, err := matchTreeMagic(path)
+	info, err := os.Lstat(path)
+	if err != nil {
+		return mediaTypes[unknownType], err
+	}
+	if !info.IsDir() {
+		dir := os.DirFS(filepath.Dir(path))
+		if img, f, ok := discImageTree(dir, filepath.Base(path), info); ok {
+			defer f.Close()
+			m, err := matchTreeMagic(img, ".", unknownType)
+			return mediaTypes[m], err
+		}
+		m, err := matchTreeMagic(dir, ".", unknownType)
+		return mediaTypes[m], err
+	}
+	m, err := matchTreeMagic(os.DirFS(path), ".", unknownDirectory)
 	return mediaTypes[m], err
 }
 
-func matchTreeMagic(path string) (int, error) {
+// MatchTreeMagicFS is the fs.FS counterpart of MatchTreeMagic,
+// for trees that don't live on the OS filesystem, such as
+// archives or in-memory fixtures. root is a slash-separated
+// path within fsys, "." for its top-level directory. As with
+// MatchTreeMagic, a disc image is examined for its contents, if
+// the file implements io.ReaderAt.
+func MatchTreeMagicFS(fsys fs.FS, root string) (MediaType, error) {
+	info, err := fs.Stat(fsys, root)
+	if err != nil {
+		return mediaTypes[unknownType], err
+	}
+	if !info.IsDir() {
+		if img, f, ok := discImageTree(fsys, root, info); ok {
+			defer f.Close()
+			m, err := matchTreeMagic(img, ".", unknownType)
+			return mediaTypes[m], err
+		}
+		m, err := matchTreeMagic(fsys, path.Dir(root), unknownType)
+		return mediaTypes[m], err
+	}
+	m, err := matchTreeMagic(fsys, root, unknownDirectory)
+	return mediaTypes[m], err
+}
+
+// MatchTreeMagicAll is the same as MatchTreeMagic, but returns
+// every x-content MIME type the directory/mounted volume matches,
+// as a medium can be several things at once, such as a video
+// DVD with an autorun program. The MIME types are ordered by the
+// priority of their signatures, highest first, so the first one
+// is the one MatchTreeMagic returns. A negative identification
+// yields a nil slice.
+func MatchTreeMagicAll(path string) ([]MediaType, error) {
 	info, err := os.Lstat(path)
 	if err != nil {
-		return unknownType, err
-	}
-	dir := path
-	isDir := info.IsDir()
-	uType := unknownType
-	if !isDir {
-		dir = filepath.Dir(dir)
-	} else {
-		uType = unknownDirectory
-	}
-	contents, lowercase := make(map[string]os.FileInfo), make(map[string]os.FileInfo)
-	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+		return nil, err
+	}
+	if !info.IsDir() {
+		dir := os.DirFS(filepath.Dir(path))
+		if img, f, ok := discImageTree(dir, filepath.Base(path), info); ok {
+			defer f.Close()
+			return mediaTypeSlice(matchTreeMagicAll(img, "."))
 		}
-		path, _ = filepath.Rel(dir, path)
-		if path == "." || path == "" {
-			return nil
+		return mediaTypeSlice(matchTreeMagicAll(dir, "."))
+	}
+	return mediaTypeSlice(matchTreeMagicAll(os.DirFS(path), "."))
+}
+
+// MatchTreeMagicAllFS is the fs.FS counterpart of
+// MatchTreeMagicAll, with root as in MatchTreeMagicFS.
+func MatchTreeMagicAllFS(fsys fs.FS, root string) ([]MediaType, error) {
+	info, err := fs.Stat(fsys, root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		if img, f, ok := discImageTree(fsys, root, info); ok {
+			defer f.Close()
+			return mediaTypeSlice(matchTreeMagicAll(img, "."))
 		}
-		contents[path], lowercase[strings.ToLower(path)] = info, info
-		return nil
-	})
+		root = path.Dir(root)
+	}
+	return mediaTypeSlice(matchTreeMagicAll(fsys, root))
+}
+
+// discImageTree opens the file name within fsys as a disc image,
+// reporting false if it isn't one. Only regular files are
+// opened, as opening a FIFO would block. The returned file is
+// to be closed once the tree has been examined.
+func discImageTree(fsys fs.FS, name string, info fs.FileInfo) (fs.FS, io.Closer, bool) {
+	if !info.Mode().IsRegular() {
+		return nil, nil, false
+	}
+	f, err := fsys.Open(name)
 	if err != nil {
-		return uType, err
+		return nil, nil, false
+	}
+	if r, ok := f.(io.ReaderAt); ok {
+		if img, err := NewDiscImageFS(r); err == nil {
+			return img, f, true
+		}
 	}
+	f.Close()
+	return nil, nil, false
+}
+
+func matchTreeMagic(fsys fs.FS, dir string, uType int) (int, error) {
+	r := &treeResolver{fsys: fsys, root: dir, dirs: make(map[string][]fs.DirEntry)}
 	for _, t := range treeMagicSignatures {
-		if t.match(contents, lowercase) {
+		if t.match(r) {
 			return t.mediaType, nil
 		}
 	}
-	return uType, nil
+	return uType, r.err
 }
 
-func (t treeMagic) match(contents, lowercase map[string]os.FileInfo) bool {
+func matchTreeMagicAll(fsys fs.FS, dir string) ([]int, error) {
+	r := &treeResolver{fsys: fsys, root: dir, dirs: make(map[string][]fs.DirEntry)}
+	var matches []treeMagic
+outer:
+	for _, t := range treeMagicSignatures {
+		for _, m := range matches {
+			if m.mediaType == t.mediaType {
+				continue outer
+			}
+		}
+		if t.match(r) {
+			matches = append(matches, t)
+		}
+	}
+	if matches == nil {
+		return nil, r.err
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].priority > matches[j].priority })
+	m := make([]int, len(matches))
+	for i := range matches {
+		m[i] = matches[i].mediaType
+	}
+	return m, nil
+}
+
+func mediaTypeSlice(matches []int, err error) ([]MediaType, error) {
+	if matches == nil {
+		return nil, err
+	}
+	results := make([]MediaType, len(matches))
+	for i, m := range matches {
+		results[i] = mediaTypes[m]
+	}
+	return results, err
+}
+
+// treeResolver looks up the paths referenced by tree magic
+// signatures, listing only the directories along the way, so
+// the cost doesn't depend on the size of the tree.
+type treeResolver struct {
+	fsys fs.FS
+	root string
+	dirs map[string][]fs.DirEntry
+	err  error
+}
+
+// readDir returns the cached listing of the directory dir,
+// relative to the root.
+func (r *treeResolver) readDir(dir string) []fs.DirEntry {
+	if entries, ok := r.dirs[dir]; ok {
+		return entries
+	}
+	entries, err := fs.ReadDir(r.fsys, path.Join(r.root, dir))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) && r.err == nil {
+		r.err = err
+	}
+	r.dirs[dir] = entries
+	return entries
+}
+
+// lookup resolves the slash-separated path p relative to the
+// root one component at a time, comparing names case
+// insensitively unless matchCase is set. Symbolic links aren't
+// followed. It returns the info of the entry and its path with
+// the actual case of every component.
+func (r *treeResolver) lookup(p string, matchCase bool) (fs.FileInfo, string, bool) {
+	dir := "."
+	var info fs.FileInfo
+	for _, name := range strings.Split(p, "/") {
+		if info != nil && !info.IsDir() {
+			return nil, "", false
+		}
+		var entry fs.DirEntry
+		for _, e := range r.readDir(dir) {
+			if e.Name() == name || !matchCase && strings.EqualFold(e.Name(), name) {
+				entry = e
+				break
+			}
+		}
+		if entry == nil {
+			return nil, "", false
+		}
+		var err error
+		if info, err = entry.Info(); err != nil {
+			return nil, "", false
+		}
+		dir = path.Join(dir, entry.Name())
+	}
+	return info, dir, true
+}
+
+// nonEmpty reports whether the directory dir, relative to the
+// root, has any entries, reading no more than one of them.
+func (r *treeResolver) nonEmpty(dir string) bool {
+	if entries, ok := r.dirs[dir]; ok {
+		return len(entries) > 0
+	}
+	f, err := r.fsys.Open(path.Join(r.root, dir))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	if d, ok := f.(fs.ReadDirFile); ok {
+		entries, _ := d.ReadDir(1)
+		return len(entries) > 0
+	}
+	return len(r.readDir(dir)) > 0
+}
+
+// mediaType determines the MIME type of the entry at p,
+// relative to the root, using both its name and its content.
+// Only regular files are opened, as opening a FIFO would block.
+func (r *treeResolver) mediaType(info fs.FileInfo, p string) int {
+	if info.IsDir() {
+		return unknownDirectory
+	}
+	if !info.Mode().IsRegular() {
+		return unknownType
+	}
+	f, err := r.fsys.Open(path.Join(r.root, p))
+	if err != nil {
+		return matchGlob(info.Name())
+	}
+	defer f.Close()
+	data, err := readData(f, magicMaxLen)
+	if err != nil {
+		return matchGlob(info.Name())
+	}
+	return match(data, info.Name(), Default)
+}
+
+func (t treeMagic) match(r *treeResolver) bool {
 	for _, tt := range t.matchers {
-		if tt.match(contents, lowercase) {
+		if tt.match(r) {
 			return true
 		}
 	}
 	return false
 }
 
-func (t treeMatch) match(contents, lowercase map[string]os.FileInfo) bool {
-	path := t.path
-	var f os.FileInfo
-	var ok bool
-	if !t.matchCase {
-		path = strings.ToLower(path)
-		f, ok = lowercase[path]
-	} else {
-		f, ok = contents[path]
-	}
+func (t treeMatch) match(r *treeResolver) bool {
+	f, p, ok := r.lookup(t.path, t.matchCase)
 	if !ok {
 		return false
 	}
@@ -106,33 +311,18 @@ func (t treeMatch) match(contents, lowercase map[string]os.FileInfo) bool {
 	if t.executable && f.Mode()&0111 == 0 {
 		return false
 	}
-	if t.mediaType > -1 {
-		if matchGlob(f.Name()) != t.mediaType {
-			return false
-		}
+	if t.mediaType > -1 && equalOrSuperClass([]int{r.mediaType(f, p)}, t.mediaType) < 0 {
+		return false
 	}
 	if t.nonEmpty {
 		if t.objectType == fileType && f.Size() == 0 {
 			return false
-		} else if t.objectType == directoryType {
-			m := contents
-			if !t.matchCase {
-				m = lowercase
-			}
-			for ff := range m {
-				if rel, err := filepath.Rel(path, ff); err == nil && rel[0] != '.' && rel != "" {
-					goto next
-				}
-			}
+		} else if t.objectType == directoryType && !r.nonEmpty(p) {
 			return false
 		}
 	}
-next:
-	if t.next == nil {
-		return true
-	}
 	for _, tt := range t.next {
-		if !tt.match(contents, lowercase) {
+		if !tt.match(r) {
 			return false
 		}
 	}
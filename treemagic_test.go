@@ -1,9 +1,14 @@
 package mimemagic
 
 import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+	"testing/fstest"
 )
 
 var treeMagicTests = []struct {
@@ -57,7 +62,7 @@ func TestMatchTreeMagic(t *testing.T) {
 	}
 	preserve := treeMagicSignatures
 	t.Run("special cases", func(t *testing.T) {
-		treeMagicSignatures = []treeMagic{{0, []treeMatch{{
+		treeMagicSignatures = []treeMagic{{0, 50, []treeMatch{{
 			"mpegav", -1, fileType,
 			false, false, false, nil,
 		}}}}
@@ -100,6 +105,208 @@ func TestMatchTreeMagic(t *testing.T) {
 	treeMagicSignatures = preserve
 }
 
+var treeMagicFS = fstest.MapFS{
+	"dvd/VIDEO_TS/VIDEO_TS.IFO":         {Data: []byte("DVDVIDEO-VMG")},
+	"dvd-lower/video_ts/video_ts.ifo":   {Data: []byte("DVDVIDEO-VMG")},
+	"software/autorun":                  {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+	"software-case/AUTORUN":             {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+	"camera/DCIM/100CANON/IMG_0001.JPG": {Data: []byte("\xff\xd8\xff")},
+	"camera-empty/DCIM":                 {Mode: fs.ModeDir | 0755},
+	"link/VIDEO_TS":                     {Data: []byte("elsewhere"), Mode: fs.ModeSymlink},
+	"windows/autorun.exe":               {Data: []byte("MZ"), Mode: 0755},
+	"hddvd/HVDVD_TS/HV000I01.IFO":       {Data: []byte("HDDVD-VMG")},
+	"hddvd/autorun":                     {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+	"plain/file.txt":                    {Data: []byte("hello")},
+}
+
+func TestMatchTreeMagicFS(t *testing.T) {
+	tests := []struct {
+		root, want string
+		wantErr    bool
+	}{
+		{"dvd", "x-content/video-dvd", false},
+		{"dvd/VIDEO_TS", "x-content/video-dvd", false},
+		{"dvd-lower", "x-content/video-dvd", false},
+		{"software", "x-content/unix-software", false},
+		{"software/autorun", "x-content/unix-software", false},
+		{"software-case", "inode/directory", false},
+		{"camera", "x-content/image-dcf", false},
+		{"camera-empty", "inode/directory", false},
+		{"link", "inode/directory", false},
+		{"windows", "x-content/win32-software", false},
+		{"hddvd", "x-content/video-hddvd", false},
+		{"plain", "inode/directory", false},
+		{"plain/file.txt", "application/octet-stream", false},
+		{".", "inode/directory", false},
+		{"non/existent", "application/octet-stream", true},
+	}
+	for _, test := range tests {
+		t.Run(test.root, func(t *testing.T) {
+			got, err := MatchTreeMagicFS(treeMagicFS, test.root)
+			if (err != nil) != test.wantErr {
+				t.Errorf("MatchTreeMagicFS() error = %v, wantErr %v", err, test.wantErr)
+				return
+			}
+			if got.MediaType() != test.want {
+				t.Errorf("MatchTreeMagicFS() = %v, want %v", got.MediaType(), test.want)
+			}
+		})
+	}
+	t.Run("zip", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w := zip.NewWriter(buf)
+		w.Create("BDMV/index.bdmv")
+		w.Close()
+		r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatalf("zip.NewReader() error = %v", err)
+		}
+		got, err := MatchTreeMagicFS(r, ".")
+		if err != nil {
+			t.Fatalf("MatchTreeMagicFS() error = %v", err)
+		}
+		if want := "x-content/video-bluray"; got.MediaType() != want {
+			t.Errorf("MatchTreeMagicFS() = %v, want %v", got.MediaType(), want)
+		}
+	})
+	t.Run("os", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "treemagic")
+		if err != nil {
+			t.Fatalf("TempDir() error = %v", err)
+		}
+		defer os.RemoveAll(dir)
+		for name, f := range treeMagicFS {
+			if f.Mode&fs.ModeSymlink != 0 {
+				continue
+			}
+			p := filepath.Join(dir, filepath.FromSlash(name))
+			if f.Mode.IsDir() {
+				os.MkdirAll(p, 0755)
+				continue
+			}
+			os.MkdirAll(filepath.Dir(p), 0755)
+			if err := ioutil.WriteFile(p, f.Data, f.Mode|0644); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+		}
+		for _, test := range tests {
+			if test.root == "link" || test.wantErr {
+				continue
+			}
+			got, err := MatchTreeMagic(filepath.Join(dir, filepath.FromSlash(test.root)))
+			if err != nil {
+				t.Errorf("MatchTreeMagic(%s) error = %v", test.root, err)
+				continue
+			}
+			if got.MediaType() != test.want {
+				t.Errorf("MatchTreeMagic(%s) = %v, want %v", test.root, got.MediaType(), test.want)
+			}
+		}
+	})
+}
+
+func TestMatchTreeMagicFSMediaType(t *testing.T) {
+	fsys := fstest.MapFS{
+		"png/cover":           {Data: pngHeader},
+		"png-text/cover":      {Data: []byte("not an image")},
+		"png-named/cover.png": {Data: []byte("not an image")},
+		"epub/book":           {Data: []byte(epubHeader)},
+		"dir/cover":           {Mode: fs.ModeDir | 0755},
+		"fifo/cover":          {Data: pngHeader, Mode: fs.ModeNamedPipe | 0644},
+	}
+	tests := []struct {
+		root, path, mediaType, want string
+	}{
+		{"png", "cover", "image/png", "all/all"},
+		{"png-text", "cover", "image/png", "inode/directory"},
+		{"png-named", "cover.png", "image/png", "all/all"},
+		{"epub", "book", "application/zip", "all/all"},
+		{"epub", "book", "application/epub+zip", "all/all"},
+		{"epub", "book", "application/x-cbz", "inode/directory"},
+		{"dir", "cover", "image/png", "inode/directory"},
+		{"dir", "cover", "inode/directory", "all/all"},
+		{"fifo", "cover", "image/png", "inode/directory"},
+		{"fifo", "cover", "application/octet-stream", "all/all"},
+	}
+	preserve := treeMagicSignatures
+	defer func() { treeMagicSignatures = preserve }()
+	for _, test := range tests {
+		t.Run(test.root+"/"+test.mediaType, func(t *testing.T) {
+			treeMagicSignatures = []treeMagic{{0, 50, []treeMatch{{
+				test.path, lookup(test.mediaType), anyType,
+				false, false, false, nil,
+			}}}}
+			got, err := MatchTreeMagicFS(fsys, test.root)
+			if err != nil {
+				t.Fatalf("MatchTreeMagicFS() error = %v", err)
+			}
+			if got.MediaType() != test.want {
+				t.Errorf("MatchTreeMagicFS() = %v, want %v", got.MediaType(), test.want)
+			}
+		})
+	}
+}
+
+func TestMatchTreeMagicAllFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dvd/VIDEO_TS/VIDEO_TS.IFO":      {Data: []byte("DVDVIDEO-VMG")},
+		"dvd/autorun":                    {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+		"kobo/.kobo/KoboReader.sqlite":   {Data: []byte("SQLite format 3\x00")},
+		"kobo/DCIM/100KOBO/IMG_0001.JPG": {Data: []byte("\xff\xd8\xff")},
+		"plain/file.txt":                 {Data: []byte("hello")},
+	}
+	tests := []struct {
+		root    string
+		want    []string
+		wantErr bool
+	}{
+		{"dvd", []string{"x-content/video-dvd", "x-content/unix-software"}, false},
+		{"dvd/autorun", []string{"x-content/video-dvd", "x-content/unix-software"}, false},
+		{"kobo", []string{"x-content/ebook-reader", "x-content/image-dcf"}, false},
+		{"plain", nil, false},
+		{"non/existent", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.root, func(t *testing.T) {
+			got, err := MatchTreeMagicAllFS(fsys, test.root)
+			if (err != nil) != test.wantErr {
+				t.Errorf("MatchTreeMagicAllFS() error = %v, wantErr %v", err, test.wantErr)
+				return
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("MatchTreeMagicAllFS() = %v, want %v", got, test.want)
+			}
+			for i := range got {
+				if got[i].MediaType() != test.want[i] {
+					t.Errorf("MatchTreeMagicAllFS()[%d] = %v, want %v", i, got[i].MediaType(), test.want[i])
+				}
+			}
+			if len(got) > 0 {
+				first, _ := MatchTreeMagicFS(fsys, test.root)
+				if first.MediaType() != got[0].MediaType() {
+					t.Errorf("MatchTreeMagicFS() = %v, want %v", first.MediaType(), got[0].MediaType())
+				}
+			}
+		})
+	}
+	t.Run("priority", func(t *testing.T) {
+		preserve := treeMagicSignatures
+		defer func() { treeMagicSignatures = preserve }()
+		treeMagicSignatures = []treeMagic{
+			{lookup("x-content/video-dvd"), 40, []treeMatch{{"VIDEO_TS", -1, directoryType, false, false, false, nil}}},
+			{lookup("x-content/unix-software"), 50, []treeMatch{{"autorun", -1, fileType, false, true, false, nil}}},
+			{lookup("x-content/video-dvd"), 30, []treeMatch{{"autorun", -1, fileType, false, false, false, nil}}},
+		}
+		got, err := MatchTreeMagicAllFS(fsys, "dvd")
+		if err != nil {
+			t.Fatalf("MatchTreeMagicAllFS() error = %v", err)
+		}
+		if len(got) != 2 || got[0].MediaType() != "x-content/unix-software" || got[1].MediaType() != "x-content/video-dvd" {
+			t.Errorf("MatchTreeMagicAllFS() = %v, want [x-content/unix-software x-content/video-dvd]", got)
+		}
+	})
+}
+
 func benchmarkMatchTreeMagic(path string, b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		MatchTreeMagic(path)
@@ -121,3 +328,47 @@ func BenchmarkMatchTreeMagic(b *testing.B) {
 		b.Run(dir.path, func(b *testing.B) { benchmarkMatchTreeMagic(filepath.Join(path, dir.path), b) })
 	}
 }
+
+func makeDeepTree(dir string, depth, fanout int) error {
+	if depth == 0 {
+		return nil
+	}
+	for i := 0; i < fanout; i++ {
+		sub := filepath.Join(dir, "dir"+string(rune('a'+i)))
+		if err := os.Mkdir(sub, 0755); err != nil {
+			return err
+		}
+		for _, name := range []string{"file.txt", "image.jpg"} {
+			if err := ioutil.WriteFile(filepath.Join(sub, name), []byte("data"), 0644); err != nil {
+				return err
+			}
+		}
+		if err := makeDeepTree(sub, depth-1, fanout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func BenchmarkMatchTreeMagicDeepTree(b *testing.B) {
+	dir, err := ioutil.TempDir("", "deeptree")
+	if err != nil {
+		b.Fatalf("TempDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	// 3905 directories and 7810 files, none of which are
+	// referenced by any of the signatures.
+	if err = makeDeepTree(dir, 5, 5); err != nil {
+		b.Fatalf("couldn't create tree: %v", err)
+	}
+	if err = os.MkdirAll(filepath.Join(dir, "DCIM", "100CANON"), 0755); err != nil {
+		b.Fatalf("MkdirAll() error = %v", err)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		m, err := MatchTreeMagic(dir)
+		if err != nil || m.MediaType() != "x-content/image-dcf" {
+			b.Fatalf("MatchTreeMagic() = %v, %v", m.MediaType(), err)
+		}
+	}
+}
@@ -0,0 +1,449 @@
+package mimemagic
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// Descriptor tag identifiers, as per ECMA-167.
+const (
+	udfAnchor            = 2
+	udfPartition         = 5
+	udfLogicalVolume     = 6
+	udfTerminating       = 8
+	udfFileSet           = 256
+	udfFileIdentifier    = 257
+	udfAllocationExtent  = 258
+	udfFileEntry         = 261
+	udfExtendedFileEntry = 266
+	udfMetadataPartition = "*UDF Metadata Partition"
+	udfMaxExtents        = 1 << 16
+	udfMaxVolumeSequence = 64
+)
+
+var errCorruptUDF = errors.New("corrupt UDF file system")
+
+// udfPartitionMap translates the logical blocks of a partition
+// to offsets within the image. A metadata partition, used by
+// Blu-ray discs, is a file within a physical partition, whose
+// extents are held in meta.
+type udfPartitionMap struct {
+	start int64
+	meta  []discSegment
+}
+
+type udfReader struct {
+	r          io.ReaderAt
+	block      int64
+	partitions []udfPartitionMap
+	// maxSize is the length of the largest partition, which no
+	// file can exceed.
+	maxSize int64
+}
+
+// NewUDFFS returns a read-only fs.FS over the UDF disc image r,
+// such as a video DVD or a Blu-ray disc. Only images with 2048
+// byte sectors are supported, which covers optical media. It
+// returns ErrNotDiscImage if r isn't a UDF image.
+func NewUDFFS(r io.ReaderAt) (fs.FS, error) {
+	if !udfRecognized(r) {
+		return nil, ErrNotDiscImage
+	}
+	b, err := readSector(r, 256)
+	if err == io.ErrUnexpectedEOF {
+		return nil, ErrNotDiscImage
+	} else if err != nil {
+		return nil, err
+	}
+	if id, ok := udfTag(b); !ok || id != udfAnchor {
+		return nil, ErrNotDiscImage
+	}
+	x := &udfReader{r: r, block: sectorLen}
+	fsd, err := x.volumeDescriptors(int64(binary.LittleEndian.Uint32(b[20:])), int64(binary.LittleEndian.Uint32(b[16:])))
+	if err != nil {
+		return nil, err
+	}
+	segments, err := x.segments(binary.LittleEndian.Uint16(fsd[8:]), binary.LittleEndian.Uint32(fsd[4:]), x.block)
+	if err != nil {
+		return nil, err
+	}
+	if b, err = x.read(segments); err != nil {
+		return nil, err
+	}
+	if id, ok := udfTag(b); !ok || id != udfFileSet {
+		return nil, errCorruptUDF
+	}
+	root, err := x.fileEntry(b[400:416])
+	if err != nil {
+		return nil, err
+	}
+	if !root.IsDir() {
+		return nil, errCorruptUDF
+	}
+	root.name = "."
+	return &discFS{r: r, root: root, readDir: x.readDir}, nil
+}
+
+// udfRecognized looks for an NSR descriptor in the volume
+// recognition sequence, which sets UDF apart from plain ISO 9660.
+func udfRecognized(r io.ReaderAt) bool {
+	for n := int64(16); n < 16+32; n++ {
+		b, err := readSector(r, n)
+		if err != nil {
+			return false
+		}
+		switch string(b[1:6]) {
+		case "NSR02", "NSR03":
+			return true
+		case "BEA01", "TEA01", "CD001", "CDW02", "BOOT2":
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// udfTag returns the identifier of the descriptor tag at the
+// start of b, and whether its checksum is valid.
+func udfTag(b []byte) (uint16, bool) {
+	if len(b) < 16 {
+		return 0, false
+	}
+	var sum byte
+	for i := 0; i < 16; i++ {
+		if i != 4 {
+			sum += b[i]
+		}
+	}
+	return binary.LittleEndian.Uint16(b), sum == b[4]
+}
+
+// volumeDescriptors reads the volume descriptor sequence of
+// length bytes at the sector loc, setting up the partition maps,
+// and returns the long_ad of the file set descriptor.
+func (x *udfReader) volumeDescriptors(loc, length int64) ([]byte, error) {
+	starts := make(map[uint16]int64)
+	var lvd []byte
+	for n := int64(0); n < length/sectorLen && n < udfMaxVolumeSequence; n++ {
+		b, err := readSector(x.r, loc+n)
+		if err != nil {
+			return nil, err
+		}
+		id, ok := udfTag(b)
+		if !ok || id == udfTerminating {
+			break
+		}
+		switch id {
+		case udfPartition:
+			starts[binary.LittleEndian.Uint16(b[22:])] = int64(binary.LittleEndian.Uint32(b[188:]))
+			if size := int64(binary.LittleEndian.Uint32(b[192:])) * x.block; size > x.maxSize {
+				x.maxSize = size
+			}
+		case udfLogicalVolume:
+			lvd = b
+		}
+	}
+	if lvd == nil {
+		return nil, errCorruptUDF
+	}
+	if bs := int64(binary.LittleEndian.Uint32(lvd[212:])); bs != sectorLen {
+		return nil, ErrNotDiscImage
+	}
+	maps := lvd[440:min(len(lvd), 440+int(binary.LittleEndian.Uint32(lvd[264:])))]
+	for i := uint32(0); i < binary.LittleEndian.Uint32(lvd[268:]) && len(maps) >= 2; i++ {
+		l := int(maps[1])
+		if l < 6 || l > len(maps) {
+			return nil, errCorruptUDF
+		}
+		m := maps[:l]
+		maps = maps[l:]
+		switch {
+		case m[0] == 1:
+			x.partitions = append(x.partitions, udfPartitionMap{start: starts[binary.LittleEndian.Uint16(m[4:])] * x.block})
+		case m[0] == 2 && l >= 44:
+			p := udfPartitionMap{start: starts[binary.LittleEndian.Uint16(m[38:])] * x.block}
+			if strings.TrimRight(string(m[5:28]), "\x00") == udfMetadataPartition {
+				meta, err := x.metadataFile(p, binary.LittleEndian.Uint32(m[40:]))
+				if err != nil {
+					return nil, err
+				}
+				p.meta = meta
+			}
+			// Sparable partitions are read as physical ones, as
+			// images don't have defective sectors to spare.
+			x.partitions = append(x.partitions, p)
+		default:
+			return nil, errCorruptUDF
+		}
+	}
+	return lvd[248:264], nil
+}
+
+// metadataFile returns the extents of the metadata file of a
+// metadata partition, located at the block lbn of the physical
+// partition p.
+func (x *udfReader) metadataFile(p udfPartitionMap, lbn uint32) ([]discSegment, error) {
+	x.partitions = append(x.partitions, p)
+	defer func() { x.partitions = x.partitions[:len(x.partitions)-1] }()
+	ad := make([]byte, 16)
+	binary.LittleEndian.PutUint32(ad, sectorLen)
+	binary.LittleEndian.PutUint32(ad[4:], lbn)
+	binary.LittleEndian.PutUint16(ad[8:], uint16(len(x.partitions)-1))
+	e, err := x.fileEntry(ad)
+	if err != nil {
+		return nil, err
+	}
+	return e.segments, nil
+}
+
+// segments returns the extents within the image of length bytes
+// at the logical block lbn of the partition ref.
+func (x *udfReader) segments(ref uint16, lbn uint32, length int64) ([]discSegment, error) {
+	if int(ref) >= len(x.partitions) {
+		return nil, errCorruptUDF
+	}
+	p := x.partitions[ref]
+	off := int64(lbn) * x.block
+	if p.meta == nil {
+		return []discSegment{{off: p.start + off, len: length}}, nil
+	}
+	var segments []discSegment
+	for _, s := range p.meta {
+		if length == 0 {
+			break
+		}
+		if off >= s.len {
+			off -= s.len
+			continue
+		}
+		n := min64(s.len-off, length)
+		segments = append(segments, discSegment{off: s.off + off, len: n, zero: s.zero})
+		length -= n
+		off = 0
+	}
+	if length > 0 {
+		return nil, errCorruptUDF
+	}
+	return segments, nil
+}
+
+func (x *udfReader) read(segments []discSegment) ([]byte, error) {
+	var b []byte
+	for _, s := range segments {
+		if int64(len(b))+s.len > maxDirLen {
+			return nil, errCorruptUDF
+		}
+		if s.zero {
+			b = append(b, make([]byte, s.len)...)
+			continue
+		}
+		bb, err := readAt(x.r, s.off, s.len)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, bb...)
+	}
+	return b, nil
+}
+
+// fileEntry reads the (extended) file entry the long_ad ad
+// points to.
+func (x *udfReader) fileEntry(ad []byte) (*discEntry, error) {
+	segments, err := x.segments(binary.LittleEndian.Uint16(ad[8:]), binary.LittleEndian.Uint32(ad[4:]), x.block)
+	if err != nil {
+		return nil, err
+	}
+	b, err := x.read(segments)
+	if err != nil {
+		return nil, err
+	}
+	var eaLen, base, mtime int
+	switch id, ok := udfTag(b); {
+	case ok && id == udfFileEntry:
+		eaLen, base, mtime = 168, 176, 84
+	case ok && id == udfExtendedFileEntry:
+		eaLen, base, mtime = 208, 216, 92
+	default:
+		return nil, errCorruptUDF
+	}
+	start := base + int(binary.LittleEndian.Uint32(b[eaLen:]))
+	end := start + int(binary.LittleEndian.Uint32(b[eaLen+4:]))
+	if start > end || end > len(b) {
+		return nil, errCorruptUDF
+	}
+	e := &discEntry{
+		size:    int64(binary.LittleEndian.Uint64(b[56:])),
+		mode:    udfMode(b[27], binary.LittleEndian.Uint32(b[44:])),
+		modTime: udfTime(b[mtime:]),
+		loc:     segments[0].off,
+	}
+	if e.size < 0 || e.size > x.maxSize {
+		return nil, errCorruptUDF
+	}
+	ads := b[start:end]
+	ref := binary.LittleEndian.Uint16(ad[8:])
+	switch flags := binary.LittleEndian.Uint16(b[34:]); flags & 7 {
+	case 0, 1:
+		e.segments, err = x.allocation(ads, flags&7 == 1, ref)
+		if err != nil {
+			return nil, err
+		}
+	case 3:
+		e.data = ads[:min64(int64(len(ads)), e.size)]
+	default:
+		return nil, errCorruptUDF
+	}
+	return e, nil
+}
+
+// allocation returns the extents described by the short_ad or
+// long_ad allocation descriptors ads, of the partition ref in
+// the case of short_ad.
+func (x *udfReader) allocation(ads []byte, long bool, ref uint16) ([]discSegment, error) {
+	adLen := 8
+	if long {
+		adLen = 16
+	}
+	var segments []discSegment
+	for i := 0; len(ads) >= adLen && i < udfMaxExtents; i++ {
+		raw, lbn := binary.LittleEndian.Uint32(ads), binary.LittleEndian.Uint32(ads[4:])
+		kind, length := raw>>30, int64(raw&0x3fffffff)
+		if long {
+			ref = binary.LittleEndian.Uint16(ads[8:])
+		}
+		ads = ads[adLen:]
+		if length == 0 {
+			break
+		}
+		switch kind {
+		case 0:
+			s, err := x.segments(ref, lbn, length)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, s...)
+		case 1, 2:
+			segments = append(segments, discSegment{len: length, zero: true})
+		case 3:
+			// The descriptors continue in an allocation extent
+			// descriptor.
+			s, err := x.segments(ref, lbn, x.block)
+			if err != nil {
+				return nil, err
+			}
+			b, err := x.read(s)
+			if err != nil {
+				return nil, err
+			}
+			if id, ok := udfTag(b); !ok || id != udfAllocationExtent {
+				return nil, errCorruptUDF
+			}
+			ads = b[24:min(len(b), 24+int(binary.LittleEndian.Uint32(b[20:])))]
+		}
+	}
+	return segments, nil
+}
+
+func (x *udfReader) readDir(dir *discEntry) ([]*discEntry, error) {
+	b, err := x.read(dir.segments)
+	if err != nil {
+		return nil, err
+	}
+	if dir.data != nil {
+		b = dir.data
+	}
+	b = b[:min64(int64(len(b)), dir.size)]
+	var entries []*discEntry
+	for len(b) >= 38 {
+		if id, ok := udfTag(b); !ok || id != udfFileIdentifier {
+			break
+		}
+		chars, idLen, iuLen := b[18], int(b[19]), int(binary.LittleEndian.Uint16(b[36:]))
+		l := (38 + iuLen + idLen + 3) &^ 3
+		if 38+iuLen+idLen > len(b) {
+			break
+		}
+		fid := b[:38+iuLen+idLen]
+		b = b[min(l, len(b)):]
+		// Deleted entries and the parent directory.
+		if chars&(4|8) != 0 {
+			continue
+		}
+		e, err := x.fileEntry(fid[20:36])
+		if err != nil {
+			continue
+		}
+		if e.name = udfString(fid[38+iuLen:]); e.name == "" {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// udfString decodes an OSTA compressed unicode file identifier.
+func udfString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	switch b[0] {
+	case 8:
+		r := make([]rune, len(b)-1)
+		for i, c := range b[1:] {
+			r[i] = rune(c)
+		}
+		return string(r)
+	case 16:
+		u := make([]uint16, (len(b)-1)/2)
+		for i := range u {
+			u[i] = binary.BigEndian.Uint16(b[1+2*i:])
+		}
+		return string(utf16.Decode(u))
+	}
+	return ""
+}
+
+// udfMode converts the ICB file type and the UDF permissions,
+// which hold five bits for each of other, group and owner, to an
+// fs.FileMode.
+func udfMode(fileType byte, perm uint32) fs.FileMode {
+	var mode fs.FileMode
+	for i := uint(0); i < 3; i++ {
+		mode |= fs.FileMode(perm>>(5*i)&7) << (3 * i)
+	}
+	switch fileType {
+	case 4:
+		mode |= fs.ModeDir
+	case 6:
+		mode |= fs.ModeDevice
+	case 7:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case 9:
+		mode |= fs.ModeNamedPipe
+	case 10:
+		mode |= fs.ModeSocket
+	case 12:
+		mode |= fs.ModeSymlink
+	}
+	return mode
+}
+
+// udfTime parses a 12 byte UDF timestamp.
+func udfTime(b []byte) time.Time {
+	year := int(int16(binary.LittleEndian.Uint16(b[2:])))
+	if year == 0 {
+		return time.Time{}
+	}
+	loc := time.UTC
+	tz := int16(binary.LittleEndian.Uint16(b)<<4) >> 4
+	if binary.LittleEndian.Uint16(b)>>12 == 1 && tz != -2047 {
+		loc = time.FixedZone("", int(tz)*60)
+	}
+	nsec := (int(b[9])*10000 + int(b[10])*100 + int(b[11])) * 1000
+	return time.Date(year, time.Month(b[4]), int(b[5]), int(b[6]), int(b[7]), int(b[8]), nsec, loc)
+}
@@ -0,0 +1,162 @@
+package mimemagic
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+)
+
+var (
+	// ErrTypeNotAllowed is returned, wrapped in an *UploadError,
+	// when the detected MIME type isn't in the allow list.
+	ErrTypeNotAllowed = errors.New("type not allowed")
+	// ErrTypeDenied is returned, wrapped in an *UploadError,
+	// when the detected MIME type is in the deny list.
+	ErrTypeDenied = errors.New("type denied")
+	// ErrTypeMismatch is returned, wrapped in an *UploadError,
+	// when the declared Content-Type of the part can't be
+	// reconciled with the detected MIME type.
+	ErrTypeMismatch = errors.New("declared type doesn't match content")
+)
+
+// UploadPolicy describes the MIME types an UploadReader accepts.
+// Types in Allow and Deny are matched with MediaType.Is, so
+// they cover aliases and subclasses, and "media/*" wildcards
+// are supported. An empty Allow list allows every type that
+// isn't denied, and Deny always takes precedence.
+// With Strict set, parts whose declared Content-Type is neither
+// equal to, a superclass nor a subclass of the detected type
+// are rejected. A missing or application/octet-stream
+// Content-Type is never considered a mismatch.
+type UploadPolicy struct {
+	Allow, Deny []string
+	Strict      bool
+}
+
+// UploadError describes a file part rejected by an UploadReader.
+type UploadError struct {
+	FormName, FileName string
+	Declared           string
+	Detected           MediaType
+	Err                error
+}
+
+func (e *UploadError) Error() string {
+	declared := e.Declared
+	if declared == "" {
+		declared = "none"
+	}
+	return fmt.Sprintf("mimemagic: file %q in form field %q: %v (declared %s, detected %s)",
+		e.FileName, e.FormName, e.Err, declared, e.Detected.MediaType())
+}
+
+// Unwrap returns the reason for the rejection, which is one of
+// ErrTypeNotAllowed, ErrTypeDenied or ErrTypeMismatch.
+func (e *UploadError) Unwrap() error { return e.Err }
+
+// UploadReader is a *multipart.Reader wrapper that identifies
+// every file part as it's read, and enforces an UploadPolicy.
+type UploadReader struct {
+	r      *multipart.Reader
+	policy UploadPolicy
+}
+
+// UploadPart is a multipart.Part that has been identified by
+// an UploadReader. Reading from it yields the full content of
+// the part, including the bytes that were examined.
+type UploadPart struct {
+	*multipart.Part
+	MediaType MediaType
+	r         io.Reader
+}
+
+func (p *UploadPart) Read(b []byte) (int, error) {
+	return p.r.Read(b)
+}
+
+// NewUploadReader returns an UploadReader that reads parts
+// from r, and enforces the policy p.
+func NewUploadReader(r *multipart.Reader, p UploadPolicy) *UploadReader {
+	return &UploadReader{r, p}
+}
+
+// NewRequestUploadReader is an *http.Request convenience wrapper
+// for NewUploadReader. It returns an error if the request isn't
+// a multipart/form-data or a multipart/mixed POST request.
+func NewRequestUploadReader(req *http.Request, p UploadPolicy) (*UploadReader, error) {
+	r, err := req.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	return NewUploadReader(r, p), nil
+}
+
+// NextPart returns the next part in the multipart body, or
+// io.EOF if there are no more parts. File parts are identified
+// using both their content and their file name, and checked
+// against the policy. If a part is rejected, the returned error
+// is an *UploadError, and the part is skipped; NextPart may be
+// called again to continue with the rest of the parts.
+// Parts without a file name, such as regular form fields, are
+// returned as is with an empty MediaType.
+func (u *UploadReader) NextPart() (*UploadPart, error) {
+	part, err := u.r.NextPart()
+	if err != nil {
+		return nil, err
+	}
+	if part.FileName() == "" {
+		return &UploadPart{Part: part, r: part}, nil
+	}
+	data := make([]byte, magicMaxLen)
+	n, err := io.ReadFull(part, data)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	data = data[:n]
+	m := Match(data, part.FileName())
+	if err = u.policy.check(m, part.Header.Get("Content-Type")); err != nil {
+		return nil, &UploadError{
+			FormName: part.FormName(),
+			FileName: part.FileName(),
+			Declared: part.Header.Get("Content-Type"),
+			Detected: m,
+			Err:      err,
+		}
+	}
+	return &UploadPart{part, m, io.MultiReader(bytes.NewReader(data), part)}, nil
+}
+
+func (p UploadPolicy) check(m MediaType, declared string) error {
+	for _, d := range p.Deny {
+		if m.Is(d) {
+			return ErrTypeDenied
+		}
+	}
+	if len(p.Allow) > 0 {
+		allowed := false
+		for _, a := range p.Allow {
+			if m.Is(a) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return ErrTypeNotAllowed
+		}
+	}
+	if !p.Strict || declared == "" {
+		return nil
+	}
+	declared, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		return ErrTypeMismatch
+	}
+	if i := lookup(declared); i == unknownType || m.Is(declared) || (i > -1 && mediaTypes[i].Is(m.MediaType())) {
+		return nil
+	}
+	return ErrTypeMismatch
+}
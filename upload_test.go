@@ -0,0 +1,134 @@
+package mimemagic
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+)
+
+const (
+	elfHeader  = "\x7fELF\x02\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x3e\x00"
+	epubHeader = "PK\x03\x04\x14\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00" +
+		"\x00\x00\x08\x00\x00\x00mimetypeapplication/epub+zip"
+)
+
+type uploadFile struct {
+	field, filename, contentType, data string
+}
+
+func multipartBody(t *testing.T, files ...uploadFile) (*bytes.Buffer, string) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	for _, f := range files {
+		h := make(textproto.MIMEHeader)
+		if f.filename != "" {
+			h.Set("Content-Disposition", `form-data; name="`+f.field+`"; filename="`+f.filename+`"`)
+		} else {
+			h.Set("Content-Disposition", `form-data; name="`+f.field+`"`)
+		}
+		if f.contentType != "" {
+			h.Set("Content-Type", f.contentType)
+		}
+		pw, err := w.CreatePart(h)
+		if err != nil {
+			t.Fatalf("CreatePart() error = %v", err)
+		}
+		pw.Write([]byte(f.data))
+	}
+	w.Close()
+	return body, w.Boundary()
+}
+
+func TestUploadReader(t *testing.T) {
+	large := string(pngHeader) + string(make([]byte, 2*magicMaxLen))
+	tests := []struct {
+		name    string
+		policy  UploadPolicy
+		file    uploadFile
+		want    string
+		wantErr error
+	}{
+		{"allowed", UploadPolicy{Allow: []string{"image/*"}},
+			uploadFile{"f", "a.png", "image/png", large}, "image/png", nil},
+		{"not allowed", UploadPolicy{Allow: []string{"image/*"}},
+			uploadFile{"f", "a.zip", "application/zip", "PK\x03\x04\x14\x00\x00\x00"}, "", ErrTypeNotAllowed},
+		{"allowed subclass", UploadPolicy{Allow: []string{"application/x-zip-compressed"}},
+			uploadFile{"f", "a.epub", "application/epub+zip", epubHeader}, "application/epub+zip", nil},
+		{"denied", UploadPolicy{Deny: []string{"application/x-executable"}},
+			uploadFile{"f", "prog", "", elfHeader}, "", ErrTypeDenied},
+		{"deny takes precedence", UploadPolicy{Allow: []string{"application/*"}, Deny: []string{"application/x-executable"}},
+			uploadFile{"f", "prog", "", elfHeader}, "", ErrTypeDenied},
+		{"mismatch", UploadPolicy{Strict: true},
+			uploadFile{"f", "a.png", "application/pdf", string(pngHeader)}, "", ErrTypeMismatch},
+		{"invalid declared type", UploadPolicy{Strict: true},
+			uploadFile{"f", "a.png", "image/", string(pngHeader)}, "", ErrTypeMismatch},
+		{"declared superclass", UploadPolicy{Strict: true},
+			uploadFile{"f", "a.epub", "application/zip", epubHeader}, "application/epub+zip", nil},
+		{"declared subclass", UploadPolicy{Strict: true},
+			uploadFile{"f", "notes", "text/x-csrc; charset=utf-8", "hello"}, "text/plain", nil},
+		{"declared octet-stream", UploadPolicy{Strict: true},
+			uploadFile{"f", "a.png", "application/octet-stream", string(pngHeader)}, "image/png", nil},
+		{"not strict", UploadPolicy{},
+			uploadFile{"f", "a.png", "application/pdf", string(pngHeader)}, "image/png", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, boundary := multipartBody(t, test.file, uploadFile{"after", "", "", "value"})
+			u := NewUploadReader(multipart.NewReader(body, boundary), test.policy)
+			p, err := u.NextPart()
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("NextPart() error = %v, want %v", err, test.wantErr)
+			}
+			if err != nil {
+				uErr, ok := err.(*UploadError)
+				if !ok {
+					t.Fatalf("NextPart() error type = %T, want *UploadError", err)
+				}
+				if uErr.FileName != test.file.filename || uErr.Declared != test.file.contentType {
+					t.Errorf("UploadError = %+v", uErr)
+				}
+			} else {
+				if got := p.MediaType.MediaType(); got != test.want {
+					t.Errorf("NextPart() = %v, want %v", got, test.want)
+				}
+				data, err := ioutil.ReadAll(p)
+				if err != nil {
+					t.Fatalf("ReadAll() error = %v", err)
+				}
+				if string(data) != test.file.data {
+					t.Errorf("ReadAll() read %d bytes, want %d", len(data), len(test.file.data))
+				}
+			}
+			p, err = u.NextPart()
+			if err != nil {
+				t.Fatalf("NextPart() error = %v", err)
+			}
+			if p.FormName() != "after" || p.MediaType.MediaType() != "/" {
+				t.Errorf("NextPart() = %v %v, want form field", p.FormName(), p.MediaType.MediaType())
+			}
+			if _, err = u.NextPart(); err != io.EOF {
+				t.Errorf("NextPart() error = %v, want %v", err, io.EOF)
+			}
+		})
+	}
+	t.Run("request", func(t *testing.T) {
+		body, boundary := multipartBody(t, uploadFile{"f", "a.png", "image/png", string(pngHeader)})
+		req := httptest.NewRequest("POST", "/", body)
+		req.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+		u, err := NewRequestUploadReader(req, UploadPolicy{Allow: []string{"image/png"}})
+		if err != nil {
+			t.Fatalf("NewRequestUploadReader() error = %v", err)
+		}
+		if _, err = u.NextPart(); err != nil {
+			t.Errorf("NextPart() error = %v", err)
+		}
+		if _, err = NewRequestUploadReader(httptest.NewRequest("GET", "/", nil), UploadPolicy{}); err == nil {
+			t.Errorf("NewRequestUploadReader() error = nil, want error")
+		}
+	})
+}